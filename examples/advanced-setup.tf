@@ -203,7 +203,7 @@ output "project_metrics" {
   description = "Project performance metrics"
   value = {
     success_rate     = data.circleci_insight.project_metrics.metrics.success_rate
-    mean_duration    = data.circleci_insight.project_metrics.metrics.mean_duration_sec
+    total_credits    = data.circleci_insight.project_metrics.metrics.total_credits_used
     total_runs       = data.circleci_insight.project_metrics.metrics.total_runs
     throughput       = data.circleci_insight.project_metrics.metrics.throughput
   }
@@ -167,9 +167,9 @@ output "project_slug" {
   value       = circleci_project.main_app.slug
 }
 
-output "usage_export_url" {
-  description = "URL to download the monthly usage report"
-  value       = circleci_usage_export.monthly.download_url
+output "usage_export_urls" {
+  description = "URLs to download the monthly usage report"
+  value       = circleci_usage_export.monthly.download_urls
   sensitive   = true
 }
 
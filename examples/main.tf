@@ -132,7 +132,7 @@ output "workflow_metrics" {
   description = "Workflow performance metrics"
   value = {
     success_rate     = data.circleci_insight.workflow_metrics.metrics.success_rate
-    median_duration  = data.circleci_insight.workflow_metrics.metrics.median_duration
+    total_credits    = data.circleci_insight.workflow_metrics.metrics.total_credits_used
     total_runs      = data.circleci_insight.workflow_metrics.metrics.total_runs
   }
 }
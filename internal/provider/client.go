@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -27,8 +28,37 @@ func (e APIError) Error() string {
 	return fmt.Sprintf("CircleCI API error: %s", e.Message)
 }
 
+// IsNotFound reports whether err is a CircleCI API error for a missing object
+func IsNotFound(err error) bool {
+	var apiErr APIError
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound
+}
+
+// IsForbidden reports whether err is a CircleCI API error for an object the
+// token may not access
+func IsForbidden(err error) bool {
+	var apiErr APIError
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusForbidden
+}
+
 // MakeRequest makes an HTTP request to the CircleCI API
 func (c *CircleCIClient) MakeRequest(ctx context.Context, method, endpoint string, body interface{}) (*http.Response, error) {
+	return c.makeRequest(ctx, c.BaseURL, method, endpoint, body)
+}
+
+// MakeV1Request makes an HTTP request to the CircleCI API v1.1, for the
+// features that have no v2 equivalent
+func (c *CircleCIClient) MakeV1Request(ctx context.Context, method, endpoint string, body interface{}) (*http.Response, error) {
+	return c.makeRequest(ctx, c.V1BaseURL(), method, endpoint, body)
+}
+
+// V1BaseURL returns the base URL of the CircleCI API v1.1, derived from the
+// configured v2 base URL
+func (c *CircleCIClient) V1BaseURL() string {
+	return strings.TrimSuffix(strings.TrimSuffix(c.BaseURL, "/"), "/v2") + "/v1.1"
+}
+
+func (c *CircleCIClient) makeRequest(ctx context.Context, baseURL, method, endpoint string, body interface{}) (*http.Response, error) {
 	var requestBody io.Reader
 
 	if body != nil {
@@ -39,7 +69,7 @@ func (c *CircleCIClient) MakeRequest(ctx context.Context, method, endpoint strin
 		requestBody = bytes.NewBuffer(jsonData)
 	}
 
-	url := c.BaseURL + endpoint
+	url := baseURL + endpoint
 	req, err := http.NewRequestWithContext(ctx, method, url, requestBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
@@ -75,6 +105,9 @@ func (c *CircleCIClient) MakeRequest(ctx context.Context, method, endpoint strin
 				Code:    resp.StatusCode,
 			}
 		}
+		if apiErr.Code == 0 {
+			apiErr.Code = resp.StatusCode
+		}
 
 		return nil, apiErr
 	}
@@ -127,6 +160,21 @@ func (c *CircleCIClient) Put(ctx context.Context, endpoint string, body interfac
 	return nil
 }
 
+// Patch makes a PATCH request to the CircleCI API
+func (c *CircleCIClient) Patch(ctx context.Context, endpoint string, body interface{}, result interface{}) error {
+	resp, err := c.MakeRequest(ctx, "PATCH", endpoint, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if result != nil {
+		return json.NewDecoder(resp.Body).Decode(result)
+	}
+
+	return nil
+}
+
 // Delete makes a DELETE request to the CircleCI API
 func (c *CircleCIClient) Delete(ctx context.Context, endpoint string) error {
 	resp, err := c.MakeRequest(ctx, "DELETE", endpoint, nil)
@@ -138,6 +186,48 @@ func (c *CircleCIClient) Delete(ctx context.Context, endpoint string) error {
 	return nil
 }
 
+// GetV1 makes a GET request to the CircleCI API v1.1
+func (c *CircleCIClient) GetV1(ctx context.Context, endpoint string, result interface{}) error {
+	resp, err := c.MakeV1Request(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if result != nil {
+		return json.NewDecoder(resp.Body).Decode(result)
+	}
+
+	return nil
+}
+
+// PostV1 makes a POST request to the CircleCI API v1.1
+func (c *CircleCIClient) PostV1(ctx context.Context, endpoint string, body interface{}, result interface{}) error {
+	resp, err := c.MakeV1Request(ctx, "POST", endpoint, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if result != nil {
+		return json.NewDecoder(resp.Body).Decode(result)
+	}
+
+	return nil
+}
+
+// DeleteV1 makes a DELETE request to the CircleCI API v1.1. Some v1.1
+// endpoints identify the object to delete in the request body.
+func (c *CircleCIClient) DeleteV1(ctx context.Context, endpoint string, body interface{}) error {
+	resp, err := c.MakeV1Request(ctx, "DELETE", endpoint, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
 // BuildURL constructs a URL with query parameters
 func BuildURL(endpoint string, params map[string]string) string {
 	if len(params) == 0 {
@@ -197,6 +287,56 @@ func (c *CircleCIClient) GetAllPages(ctx context.Context, endpoint string, param
 	return allItems, nil
 }
 
+// TypedPaginatedResponse represents a paginated API response whose items
+// decode into T
+type TypedPaginatedResponse[T any] struct {
+	Items         []T    `json:"items"`
+	NextPageToken string `json:"next_page_token,omitempty"`
+}
+
+// GetAllPagesOf retrieves all pages of a paginated API response, decoding the
+// items into T
+func GetAllPagesOf[T any](ctx context.Context, c *CircleCIClient, endpoint string, params map[string]string) ([]T, error) {
+	return GetPagesOf[T](ctx, c, endpoint, params, 0)
+}
+
+// GetPagesOf retrieves pages of a paginated API response until limit items
+// have been collected, decoding the items into T. A limit of 0 retrieves all
+// pages.
+func GetPagesOf[T any](ctx context.Context, c *CircleCIClient, endpoint string, params map[string]string, limit int) ([]T, error) {
+	var allItems []T
+	nextPageToken := ""
+
+	for {
+		queryParams := make(map[string]string)
+		for k, v := range params {
+			queryParams[k] = v
+		}
+
+		if nextPageToken != "" {
+			queryParams["page-token"] = nextPageToken
+		}
+
+		var response TypedPaginatedResponse[T]
+		if err := c.Get(ctx, BuildURL(endpoint, queryParams), &response); err != nil {
+			return nil, err
+		}
+
+		allItems = append(allItems, response.Items...)
+
+		if limit > 0 && len(allItems) >= limit {
+			return allItems[:limit], nil
+		}
+
+		if response.NextPageToken == "" {
+			break
+		}
+		nextPageToken = response.NextPageToken
+	}
+
+	return allItems, nil
+}
+
 // ParseID parses various ID formats used in CircleCI (UUID, slug, etc.)
 func ParseID(id string) (string, error) {
 	if id == "" {
@@ -217,9 +357,30 @@ func ParseID(id string) (string, error) {
 	return id, nil
 }
 
-// EscapeProjectSlug URL encodes a project slug for use in API endpoints
+// EscapeProjectSlug URL encodes a project slug for use in API endpoints. Each
+// segment is escaped on its own so the separators survive, which the
+// circleci/<org-id>/<project-id> slugs of GitHub App and GitLab projects need.
 func EscapeProjectSlug(slug string) string {
-	return url.PathEscape(slug)
+	segments := strings.Split(slug, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// V1ProjectPath converts a project slug into the path of the project in the
+// CircleCI API v1.1, which spells out the VCS type
+func V1ProjectPath(slug string) string {
+	parts := strings.SplitN(slug, "/", 2)
+	if len(parts) == 2 {
+		switch parts[0] {
+		case "gh":
+			parts[0] = "github"
+		case "bb":
+			parts[0] = "bitbucket"
+		}
+	}
+	return strings.Join(parts, "/")
 }
 
 // ValidateUUID checks if a string is a valid UUID
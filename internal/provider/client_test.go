@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetPagesOf(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Query().Get("page-token") {
+		case "":
+			fmt.Fprint(w, `{"items":[1,2],"next_page_token":"p2"}`)
+		case "p2":
+			fmt.Fprint(w, `{"items":[3,4],"next_page_token":"p3"}`)
+		default:
+			fmt.Fprint(w, `{"items":[5]}`)
+		}
+	}))
+	defer server.Close()
+
+	client := &CircleCIClient{BaseURL: server.URL, HTTPClient: server.Client()}
+
+	items, err := GetPagesOf[int](context.Background(), client, "/items", nil, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 3 || requests != 2 {
+		t.Errorf("got %v after %d requests, want 3 items after 2 requests", items, requests)
+	}
+
+	requests = 0
+	items, err = GetAllPagesOf[int](context.Background(), client, "/items", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 5 || requests != 3 {
+		t.Errorf("got %v after %d requests, want 5 items after 3 requests", items, requests)
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"Not found."}`)
+	}))
+	defer server.Close()
+
+	client := &CircleCIClient{BaseURL: server.URL, HTTPClient: server.Client()}
+
+	err := client.Get(context.Background(), "/missing", nil)
+	if !IsNotFound(err) {
+		t.Errorf("IsNotFound(%v) = false, want true", err)
+	}
+	if IsNotFound(fmt.Errorf("connection refused")) {
+		t.Error("IsNotFound() = true for a transport error")
+	}
+}
+
+func TestEscapeProjectSlug(t *testing.T) {
+	tests := []struct {
+		slug string
+		want string
+	}{
+		{"gh/acme/api", "gh/acme/api"},
+		{"circleci/9a1b2c3d-0000-4000-8000-000000000000/5e6f7a8b-0000-4000-8000-000000000000", "circleci/9a1b2c3d-0000-4000-8000-000000000000/5e6f7a8b-0000-4000-8000-000000000000"},
+		{"gh/acme/my repo", "gh/acme/my%20repo"},
+		{"bb/acme/a?b", "bb/acme/a%3Fb"},
+	}
+
+	for _, tt := range tests {
+		if got := EscapeProjectSlug(tt.slug); got != tt.want {
+			t.Errorf("EscapeProjectSlug(%q) = %q, want %q", tt.slug, got, tt.want)
+		}
+	}
+}
+
+func TestV1ProjectPath(t *testing.T) {
+	tests := map[string]string{
+		"gh/acme/api":        "github/acme/api",
+		"bb/acme/api":        "bitbucket/acme/api",
+		"github/acme/api":    "github/acme/api",
+		"circleci/uuid/uuid": "circleci/uuid/uuid",
+	}
+
+	for slug, want := range tests {
+		if got := V1ProjectPath(slug); got != want {
+			t.Errorf("V1ProjectPath(%q) = %q, want %q", slug, got, want)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CheckoutKeysDataSource{}
+
+func NewCheckoutKeysDataSource() datasource.DataSource {
+	return &CheckoutKeysDataSource{}
+}
+
+type CheckoutKeysDataSource struct {
+	client *CircleCIClient
+}
+
+type CheckoutKeysDataSourceModel struct {
+	ProjectSlug types.String               `tfsdk:"project_slug"`
+	Type        types.String               `tfsdk:"type"`
+	Keys        []CheckoutKeyDataItemModel `tfsdk:"keys"`
+}
+
+type CheckoutKeyDataItemModel struct {
+	Type        types.String `tfsdk:"type"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+	PublicKey   types.String `tfsdk:"public_key"`
+	Preferred   types.Bool   `tfsdk:"preferred"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+func (d *CheckoutKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_checkout_keys"
+}
+
+func (d *CheckoutKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Checkout Keys data source. Lists the checkout keys of a project.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_slug": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The project slug in the form 'vcs-slug/org-name/repo-name'.",
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return keys of this type, 'user-key' or 'deploy-key'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(checkoutKeyTypes...),
+				},
+			},
+			"keys": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The checkout keys of the project.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of checkout key, 'user-key' or 'deploy-key'.",
+						},
+						"fingerprint": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The SSH fingerprint of the checkout key.",
+						},
+						"public_key": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The public SSH key.",
+						},
+						"preferred": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether this is the key CircleCI uses to check out the project.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The date and time when the checkout key was created.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CheckoutKeysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CheckoutKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CheckoutKeysDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys, err := listCheckoutKeys(ctx, d.client, data.ProjectSlug.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list checkout keys, got error: %s", err))
+		return
+	}
+
+	data.Keys = []CheckoutKeyDataItemModel{}
+	for _, key := range keys {
+		if !data.Type.IsNull() && key.Type != data.Type.ValueString() {
+			continue
+		}
+
+		data.Keys = append(data.Keys, CheckoutKeyDataItemModel{
+			Type:        types.StringValue(key.Type),
+			Fingerprint: types.StringValue(key.Fingerprint),
+			PublicKey:   types.StringValue(key.PublicKey),
+			Preferred:   types.BoolValue(key.Preferred),
+			CreatedAt:   types.StringValue(key.CreatedAt),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CollaborationsDataSource{}
+
+func NewCollaborationsDataSource() datasource.DataSource {
+	return &CollaborationsDataSource{}
+}
+
+type CollaborationsDataSource struct {
+	client *CircleCIClient
+}
+
+type CollaborationsDataSourceModel struct {
+	Collaborations []CollaborationDataItemModel `tfsdk:"collaborations"`
+}
+
+type CollaborationDataItemModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Slug      types.String `tfsdk:"slug"`
+	VcsType   types.String `tfsdk:"vcs_type"`
+	AvatarURL types.String `tfsdk:"avatar_url"`
+}
+
+// CircleCI API models for collaborations
+type CollaborationAPI struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Slug      string `json:"slug"`
+	VcsType   string `json:"vcs-type"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+// listCollaborations returns the organizations the API token can see.
+func listCollaborations(ctx context.Context, c *CircleCIClient) ([]CollaborationAPI, error) {
+	var collaborations []CollaborationAPI
+	if err := c.Get(ctx, "/me/collaborations", &collaborations); err != nil {
+		return nil, err
+	}
+	return collaborations, nil
+}
+
+// findCollaboration returns the first collaboration accepted by match, or nil
+// if there is none.
+func findCollaboration(collaborations []CollaborationAPI, match func(CollaborationAPI) bool) *CollaborationAPI {
+	for i := range collaborations {
+		if match(collaborations[i]) {
+			return &collaborations[i]
+		}
+	}
+	return nil
+}
+
+func (d *CollaborationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_collaborations"
+}
+
+func (d *CollaborationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Collaborations data source. Lists the organizations the API token can see.",
+
+		Attributes: map[string]schema.Attribute{
+			"collaborations": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The organizations the user collaborates on.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the organization.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the organization.",
+						},
+						"slug": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The slug of the organization, such as 'gh/acme' or 'circleci/<uuid>'.",
+						},
+						"vcs_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The version control system type (e.g., 'github', 'bitbucket', 'circleci').",
+						},
+						"avatar_url": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The URL of the organization's avatar image.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CollaborationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CollaborationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CollaborationsDataSourceModel
+
+	collaborations, err := listCollaborations(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list collaborations, got error: %s", err))
+		return
+	}
+
+	data.Collaborations = make([]CollaborationDataItemModel, len(collaborations))
+	for i, collaboration := range collaborations {
+		data.Collaborations[i] = CollaborationDataItemModel{
+			ID:        types.StringValue(collaboration.ID),
+			Name:      types.StringValue(collaboration.Name),
+			Slug:      types.StringValue(collaboration.Slug),
+			VcsType:   types.StringValue(collaboration.VcsType),
+			AvatarURL: types.StringValue(collaboration.AvatarURL),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
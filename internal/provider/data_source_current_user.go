@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CurrentUserDataSource{}
+
+func NewCurrentUserDataSource() datasource.DataSource {
+	return &CurrentUserDataSource{}
+}
+
+type CurrentUserDataSource struct {
+	client *CircleCIClient
+}
+
+type CurrentUserDataSourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Login types.String `tfsdk:"login"`
+	Name  types.String `tfsdk:"name"`
+}
+
+// CircleCI API models for the current user
+type CurrentUserAPI struct {
+	ID    string `json:"id"`
+	Login string `json:"login"`
+	Name  string `json:"name"`
+}
+
+func (d *CurrentUserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_user"
+}
+
+func (d *CurrentUserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Current User data source. Describes the user who owns the API token.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the user.",
+			},
+			"login": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The login of the user.",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The display name of the user.",
+			},
+		},
+	}
+}
+
+func (d *CurrentUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CurrentUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CurrentUserDataSourceModel
+
+	var user CurrentUserAPI
+	if err := d.client.Get(ctx, "/me", &user); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read current user, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(user.ID)
+	data.Login = types.StringValue(user.Login)
+	data.Name = types.StringValue(user.Name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -3,10 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -21,29 +24,84 @@ type InsightDataSource struct {
 }
 
 type InsightDataSourceModel struct {
-	ProjectSlug types.String `tfsdk:"project_slug"`
-	Branch      types.String `tfsdk:"branch"`
-	Workflow    types.String `tfsdk:"workflow"`
-	Metrics     types.Object `tfsdk:"metrics"`
+	ProjectSlug           types.String             `tfsdk:"project_slug"`
+	Branch                types.String             `tfsdk:"branch"`
+	Workflow              types.String             `tfsdk:"workflow"`
+	ReportingWindow       types.String             `tfsdk:"reporting_window"`
+	TimeseriesGranularity types.String             `tfsdk:"timeseries_granularity"`
+	Metrics               InsightsOrgMetricsModel  `tfsdk:"metrics"`
+	Trends                InsightsOrgTrendsModel   `tfsdk:"trends"`
+	Workflows             []InsightWorkflowModel   `tfsdk:"workflows"`
+	Timeseries            []InsightTimeseriesModel `tfsdk:"timeseries"`
 }
 
-type InsightMetrics struct {
-	TotalRuns      types.Int64   `tfsdk:"total_runs"`
-	SuccessfulRuns types.Int64   `tfsdk:"successful_runs"`
-	MedianDuration types.Float64 `tfsdk:"median_duration"`
-	P95Duration    types.Float64 `tfsdk:"p95_duration"`
-	SuccessRate    types.Float64 `tfsdk:"success_rate"`
-	Throughput     types.Float64 `tfsdk:"throughput"`
+type InsightWorkflowModel struct {
+	Name             types.String  `tfsdk:"name"`
+	TotalRuns        types.Int64   `tfsdk:"total_runs"`
+	SuccessRate      types.Float64 `tfsdk:"success_rate"`
+	TotalCreditsUsed types.Int64   `tfsdk:"total_credits_used"`
+	P95DurationSecs  types.Float64 `tfsdk:"p95_duration_secs"`
 }
 
-// CircleCI API models for insights
+type InsightTimeseriesModel struct {
+	Name               types.String  `tfsdk:"name"`
+	Timestamp          types.String  `tfsdk:"timestamp"`
+	MinStartedAt       types.String  `tfsdk:"min_started_at"`
+	MaxEndedAt         types.String  `tfsdk:"max_ended_at"`
+	TotalRuns          types.Int64   `tfsdk:"total_runs"`
+	SuccessfulRuns     types.Int64   `tfsdk:"successful_runs"`
+	FailedRuns         types.Int64   `tfsdk:"failed_runs"`
+	Throughput         types.Float64 `tfsdk:"throughput"`
+	TotalCreditsUsed   types.Int64   `tfsdk:"total_credits_used"`
+	MedianCreditsUsed  types.Int64   `tfsdk:"median_credits_used"`
+	MinDurationSecs    types.Int64   `tfsdk:"min_duration_secs"`
+	MedianDurationSecs types.Int64   `tfsdk:"median_duration_secs"`
+	P95DurationSecs    types.Int64   `tfsdk:"p95_duration_secs"`
+	MaxDurationSecs    types.Int64   `tfsdk:"max_duration_secs"`
+	TotalDurationSecs  types.Int64   `tfsdk:"total_duration_secs"`
+}
+
+// CircleCI API models for insights. The summary endpoint nests project-level
+// metrics under project_data and per-workflow metrics under
+// project_workflow_data.
 type InsightsResponse struct {
-	TotalRuns      int     `json:"total_runs"`
-	SuccessfulRuns int     `json:"successful_runs"`
-	MedianDuration float64 `json:"median_duration_sec"`
-	P95Duration    float64 `json:"p95_duration_sec"`
-	SuccessRate    float64 `json:"success_rate"`
-	Throughput     float64 `json:"throughput"`
+	OrgID       string `json:"org_id"`
+	ProjectID   string `json:"project_id"`
+	ProjectData struct {
+		Metrics InsightsOrgMetricsAPI `json:"metrics"`
+		Trends  InsightsOrgMetricsAPI `json:"trends"`
+	} `json:"project_data"`
+	ProjectWorkflowData []struct {
+		WorkflowName string `json:"workflow_name"`
+		Metrics      struct {
+			TotalRuns        int64   `json:"total_runs"`
+			SuccessRate      float64 `json:"success_rate"`
+			TotalCreditsUsed int64   `json:"total_credits_used"`
+			P95DurationSecs  float64 `json:"p95_duration_secs"`
+		} `json:"metrics"`
+	} `json:"project_workflow_data"`
+}
+
+type InsightsTimeseriesAPI struct {
+	Name         string `json:"name"`
+	Timestamp    string `json:"timestamp"`
+	MinStartedAt string `json:"min_started_at"`
+	MaxEndedAt   string `json:"max_ended_at"`
+	Metrics      struct {
+		TotalRuns         int64   `json:"total_runs"`
+		SuccessfulRuns    int64   `json:"successful_runs"`
+		FailedRuns        int64   `json:"failed_runs"`
+		Throughput        float64 `json:"throughput"`
+		TotalCreditsUsed  int64   `json:"total_credits_used"`
+		MedianCreditsUsed int64   `json:"median_credits_used"`
+		DurationMetrics   struct {
+			Min    int64 `json:"min"`
+			Median int64 `json:"median"`
+			P95    int64 `json:"p95"`
+			Max    int64 `json:"max"`
+			Total  int64 `json:"total"`
+		} `json:"duration_metrics"`
+	} `json:"metrics"`
 }
 
 func (d *InsightDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -60,40 +118,130 @@ func (d *InsightDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				Required:            true,
 			},
 			"branch": schema.StringAttribute{
-				MarkdownDescription: "The branch name to get insights for. Defaults to the default branch.",
+				MarkdownDescription: "The branch name to get insights for. Defaults to all branches.",
 				Optional:            true,
 			},
 			"workflow": schema.StringAttribute{
-				MarkdownDescription: "The workflow name to get insights for. If not specified, gets insights for all workflows.",
+				MarkdownDescription: "The workflow name to get insights for. If not specified, gets insights for all workflows. Required when 'timeseries_granularity' is set.",
+				Optional:            true,
+			},
+			"reporting_window": schema.StringAttribute{
+				MarkdownDescription: "The time window used to calculate metrics. One of 'last-24-hours', 'last-7-days', 'last-30-days', 'last-60-days', 'last-90-days'. Defaults to 'last-90-days'.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(insightsReportingWindows...),
+				},
+			},
+			"timeseries_granularity": schema.StringAttribute{
+				MarkdownDescription: "When set, also fetch per-job timeseries for the workflow bucketed by 'daily' or 'hourly'.",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("daily", "hourly"),
+				},
 			},
 			"metrics": schema.SingleNestedAttribute{
-				MarkdownDescription: "The workflow metrics and insights.",
+				MarkdownDescription: "The project metrics for the reporting window.",
 				Computed:            true,
-				Attributes: map[string]schema.Attribute{
-					"total_runs": schema.Int64Attribute{
-						MarkdownDescription: "The total number of workflow runs.",
-						Computed:            true,
-					},
-					"successful_runs": schema.Int64Attribute{
-						MarkdownDescription: "The number of successful workflow runs.",
-						Computed:            true,
-					},
-					"median_duration": schema.Float64Attribute{
-						MarkdownDescription: "The median duration of workflow runs in seconds.",
-						Computed:            true,
-					},
-					"p95_duration": schema.Float64Attribute{
-						MarkdownDescription: "The 95th percentile duration of workflow runs in seconds.",
-						Computed:            true,
-					},
-					"success_rate": schema.Float64Attribute{
-						MarkdownDescription: "The success rate of workflow runs (0.0 to 1.0).",
-						Computed:            true,
+				Attributes:          insightsOrgMetricsAttributes(),
+			},
+			"trends": schema.SingleNestedAttribute{
+				MarkdownDescription: "The project metrics relative to the previous reporting window.",
+				Computed:            true,
+				Attributes:          insightsOrgTrendsAttributes(),
+			},
+			"workflows": schema.ListNestedAttribute{
+				MarkdownDescription: "Per-workflow metrics for the reporting window.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the workflow.",
+							Computed:            true,
+						},
+						"total_runs": schema.Int64Attribute{
+							MarkdownDescription: "The total number of workflow runs.",
+							Computed:            true,
+						},
+						"success_rate": schema.Float64Attribute{
+							MarkdownDescription: "The success rate of workflow runs (0.0 to 1.0).",
+							Computed:            true,
+						},
+						"total_credits_used": schema.Int64Attribute{
+							MarkdownDescription: "The total credits consumed by the workflow.",
+							Computed:            true,
+						},
+						"p95_duration_secs": schema.Float64Attribute{
+							MarkdownDescription: "The 95th percentile duration of workflow runs in seconds.",
+							Computed:            true,
+						},
 					},
-					"throughput": schema.Float64Attribute{
-						MarkdownDescription: "The average number of workflow runs per day.",
-						Computed:            true,
+				},
+			},
+			"timeseries": schema.ListNestedAttribute{
+				MarkdownDescription: "Per-job metrics bucketed by 'timeseries_granularity'. Only populated when 'timeseries_granularity' is set.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the job.",
+							Computed:            true,
+						},
+						"timestamp": schema.StringAttribute{
+							MarkdownDescription: "The start of the bucket.",
+							Computed:            true,
+						},
+						"min_started_at": schema.StringAttribute{
+							MarkdownDescription: "The earliest start time of a run in the bucket.",
+							Computed:            true,
+						},
+						"max_ended_at": schema.StringAttribute{
+							MarkdownDescription: "The latest end time of a run in the bucket.",
+							Computed:            true,
+						},
+						"total_runs": schema.Int64Attribute{
+							MarkdownDescription: "The total number of runs.",
+							Computed:            true,
+						},
+						"successful_runs": schema.Int64Attribute{
+							MarkdownDescription: "The number of successful runs.",
+							Computed:            true,
+						},
+						"failed_runs": schema.Int64Attribute{
+							MarkdownDescription: "The number of failed runs.",
+							Computed:            true,
+						},
+						"throughput": schema.Float64Attribute{
+							MarkdownDescription: "The average number of runs per bucket.",
+							Computed:            true,
+						},
+						"total_credits_used": schema.Int64Attribute{
+							MarkdownDescription: "The total credits consumed.",
+							Computed:            true,
+						},
+						"median_credits_used": schema.Int64Attribute{
+							MarkdownDescription: "The median credits consumed per run.",
+							Computed:            true,
+						},
+						"min_duration_secs": schema.Int64Attribute{
+							MarkdownDescription: "The shortest run duration in seconds.",
+							Computed:            true,
+						},
+						"median_duration_secs": schema.Int64Attribute{
+							MarkdownDescription: "The median run duration in seconds.",
+							Computed:            true,
+						},
+						"p95_duration_secs": schema.Int64Attribute{
+							MarkdownDescription: "The 95th percentile run duration in seconds.",
+							Computed:            true,
+						},
+						"max_duration_secs": schema.Int64Attribute{
+							MarkdownDescription: "The longest run duration in seconds.",
+							Computed:            true,
+						},
+						"total_duration_secs": schema.Int64Attribute{
+							MarkdownDescription: "The total duration of all runs in seconds.",
+							Computed:            true,
+						},
 					},
 				},
 			},
@@ -126,49 +274,193 @@ func (d *InsightDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	hasWorkflow := !data.Workflow.IsNull() && !data.Workflow.IsUnknown()
+	hasBranch := !data.Branch.IsNull() && !data.Branch.IsUnknown()
+
+	if !data.TimeseriesGranularity.IsNull() && !hasWorkflow {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("workflow"),
+			"Missing Required Attribute",
+			"'workflow' must be specified when 'timeseries_granularity' is set",
+		)
+		return
+	}
+
 	slug := EscapeProjectSlug(data.ProjectSlug.ValueString())
 
 	// Build the endpoint with optional parameters
 	endpoint := fmt.Sprintf("/insights/pages/%s/summary", slug)
 	params := make(map[string]string)
 
-	if !data.Branch.IsNull() && !data.Branch.IsUnknown() {
-		params["branch"] = data.Branch.ValueString()
+	if hasBranch {
+		params["branches"] = data.Branch.ValueString()
 	}
 
-	if !data.Workflow.IsNull() && !data.Workflow.IsUnknown() {
-		params["workflow_name"] = data.Workflow.ValueString()
+	if hasWorkflow {
+		params["workflow-names"] = data.Workflow.ValueString()
 	}
 
-	url := BuildURL(endpoint, params)
+	if !data.ReportingWindow.IsNull() && !data.ReportingWindow.IsUnknown() {
+		params["reporting-window"] = data.ReportingWindow.ValueString()
+	}
 
 	var insights InsightsResponse
-	if err := d.client.Get(ctx, url, &insights); err != nil {
+	if err := d.client.Get(ctx, BuildURL(endpoint, params), &insights); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read insights, got error: %s", err))
 		return
 	}
 
-	// Convert to metrics object
-	metricsObj, diags := types.ObjectValueFrom(ctx, map[string]attr.Type{
-		"total_runs":      types.Int64Type,
-		"successful_runs": types.Int64Type,
-		"median_duration": types.Float64Type,
-		"p95_duration":    types.Float64Type,
-		"success_rate":    types.Float64Type,
-		"throughput":      types.Float64Type,
-	}, InsightMetrics{
-		TotalRuns:      types.Int64Value(int64(insights.TotalRuns)),
-		SuccessfulRuns: types.Int64Value(int64(insights.SuccessfulRuns)),
-		MedianDuration: types.Float64Value(insights.MedianDuration),
-		P95Duration:    types.Float64Value(insights.P95Duration),
-		SuccessRate:    types.Float64Value(insights.SuccessRate),
-		Throughput:     types.Float64Value(insights.Throughput),
-	})
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	data.Metrics = newInsightsOrgMetricsModel(insights.ProjectData.Metrics)
+	data.Trends = InsightsOrgTrendsModel{
+		TotalRuns:         types.Float64Value(insights.ProjectData.Trends.TotalRuns),
+		TotalDurationSecs: types.Float64Value(insights.ProjectData.Trends.TotalDurationSecs),
+		TotalCreditsUsed:  types.Float64Value(insights.ProjectData.Trends.TotalCreditsUsed),
+		SuccessRate:       types.Float64Value(insights.ProjectData.Trends.SuccessRate),
+		Throughput:        types.Float64Value(insights.ProjectData.Trends.Throughput),
+	}
+
+	data.Workflows = make([]InsightWorkflowModel, len(insights.ProjectWorkflowData))
+	for i, workflow := range insights.ProjectWorkflowData {
+		data.Workflows[i] = InsightWorkflowModel{
+			Name:             types.StringValue(workflow.WorkflowName),
+			TotalRuns:        types.Int64Value(workflow.Metrics.TotalRuns),
+			SuccessRate:      types.Float64Value(workflow.Metrics.SuccessRate),
+			TotalCreditsUsed: types.Int64Value(workflow.Metrics.TotalCreditsUsed),
+			P95DurationSecs:  types.Float64Value(workflow.Metrics.P95DurationSecs),
+		}
+	}
+
+	if !data.TimeseriesGranularity.IsNull() && !data.TimeseriesGranularity.IsUnknown() {
+		timeseriesEndpoint := fmt.Sprintf("/insights/time-series/%s/workflows/%s/jobs", slug, url.PathEscape(data.Workflow.ValueString()))
+		timeseriesParams := map[string]string{
+			"granularity": data.TimeseriesGranularity.ValueString(),
+		}
+
+		if hasBranch {
+			timeseriesParams["branch"] = data.Branch.ValueString()
+		}
+
+		buckets, err := GetAllPagesOf[InsightsTimeseriesAPI](ctx, d.client, timeseriesEndpoint, timeseriesParams)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read insights timeseries, got error: %s", err))
+			return
+		}
+
+		data.Timeseries = make([]InsightTimeseriesModel, len(buckets))
+		for i, bucket := range buckets {
+			data.Timeseries[i] = InsightTimeseriesModel{
+				Name:               types.StringValue(bucket.Name),
+				Timestamp:          types.StringValue(bucket.Timestamp),
+				MinStartedAt:       types.StringValue(bucket.MinStartedAt),
+				MaxEndedAt:         types.StringValue(bucket.MaxEndedAt),
+				TotalRuns:          types.Int64Value(bucket.Metrics.TotalRuns),
+				SuccessfulRuns:     types.Int64Value(bucket.Metrics.SuccessfulRuns),
+				FailedRuns:         types.Int64Value(bucket.Metrics.FailedRuns),
+				Throughput:         types.Float64Value(bucket.Metrics.Throughput),
+				TotalCreditsUsed:   types.Int64Value(bucket.Metrics.TotalCreditsUsed),
+				MedianCreditsUsed:  types.Int64Value(bucket.Metrics.MedianCreditsUsed),
+				MinDurationSecs:    types.Int64Value(bucket.Metrics.DurationMetrics.Min),
+				MedianDurationSecs: types.Int64Value(bucket.Metrics.DurationMetrics.Median),
+				P95DurationSecs:    types.Int64Value(bucket.Metrics.DurationMetrics.P95),
+				MaxDurationSecs:    types.Int64Value(bucket.Metrics.DurationMetrics.Max),
+				TotalDurationSecs:  types.Int64Value(bucket.Metrics.DurationMetrics.Total),
+			}
+		}
 	}
-	data.Metrics = metricsObj
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// insightsReportingWindows lists the reporting windows accepted by the
+// insights API.
+var insightsReportingWindows = []string{
+	"last-24-hours",
+	"last-7-days",
+	"last-30-days",
+	"last-60-days",
+	"last-90-days",
+}
+
+// insightsQueryParams builds the query parameters shared by the insights
+// endpoints.
+func insightsQueryParams(branch types.String, allBranches types.Bool, reportingWindow types.String) map[string]string {
+	params := make(map[string]string)
+
+	if !branch.IsNull() && !branch.IsUnknown() {
+		params["branch"] = branch.ValueString()
+	}
+
+	if !allBranches.IsNull() && !allBranches.IsUnknown() {
+		params["all-branches"] = ConvertBoolToString(allBranches.ValueBool())
+	}
+
+	if !reportingWindow.IsNull() && !reportingWindow.IsUnknown() {
+		params["reporting-window"] = reportingWindow.ValueString()
+	}
+
+	return params
+}
+
+// InsightsDurationMetricsAPI is the duration breakdown returned by the
+// insights API, in seconds.
+type InsightsDurationMetricsAPI struct {
+	Min               float64 `json:"min"`
+	Mean              float64 `json:"mean"`
+	Median            float64 `json:"median"`
+	P95               float64 `json:"p95"`
+	Max               float64 `json:"max"`
+	StandardDeviation float64 `json:"standard_deviation"`
+}
+
+type InsightsDurationMetricsModel struct {
+	Min               types.Float64 `tfsdk:"min"`
+	Mean              types.Float64 `tfsdk:"mean"`
+	Median            types.Float64 `tfsdk:"median"`
+	P95               types.Float64 `tfsdk:"p95"`
+	Max               types.Float64 `tfsdk:"max"`
+	StandardDeviation types.Float64 `tfsdk:"standard_deviation"`
+}
+
+func newInsightsDurationMetricsModel(m InsightsDurationMetricsAPI) InsightsDurationMetricsModel {
+	return InsightsDurationMetricsModel{
+		Min:               types.Float64Value(m.Min),
+		Mean:              types.Float64Value(m.Mean),
+		Median:            types.Float64Value(m.Median),
+		P95:               types.Float64Value(m.P95),
+		Max:               types.Float64Value(m.Max),
+		StandardDeviation: types.Float64Value(m.StandardDeviation),
+	}
+}
+
+func insightsDurationMetricsSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "Duration statistics in seconds.",
+		Attributes: map[string]schema.Attribute{
+			"min": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The shortest duration.",
+			},
+			"mean": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The mean duration.",
+			},
+			"median": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The median duration.",
+			},
+			"p95": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The 95th percentile duration.",
+			},
+			"max": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The longest duration.",
+			},
+			"standard_deviation": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The standard deviation of the duration.",
+			},
+		},
+	}
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &InsightsBranchesDataSource{}
+
+func NewInsightsBranchesDataSource() datasource.DataSource {
+	return &InsightsBranchesDataSource{}
+}
+
+type InsightsBranchesDataSource struct {
+	client *CircleCIClient
+}
+
+type InsightsBranchesDataSourceModel struct {
+	ProjectSlug     types.String `tfsdk:"project_slug"`
+	WorkflowName    types.String `tfsdk:"workflow_name"`
+	Branch          types.String `tfsdk:"branch"`
+	AllBranches     types.Bool   `tfsdk:"all_branches"`
+	ReportingWindow types.String `tfsdk:"reporting_window"`
+	ProjectID       types.String `tfsdk:"project_id"`
+	OrgID           types.String `tfsdk:"org_id"`
+	Branches        types.List   `tfsdk:"branches"`
+}
+
+// CircleCI API models for insights branches
+type InsightsBranchesResponse struct {
+	OrgID         string   `json:"org_id"`
+	ProjectID     string   `json:"project_id"`
+	Branches      []string `json:"branches"`
+	NextPageToken string   `json:"next_page_token,omitempty"`
+}
+
+func (d *InsightsBranchesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_insights_branches"
+}
+
+func (d *InsightsBranchesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Insights Branches data source. Lists the branches that have insights data for a project.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_slug": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The project slug in the form 'vcs-slug/org-name/repo-name'.",
+			},
+			"workflow_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list branches that ran this workflow.",
+			},
+			"branch": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The branch to filter on.",
+			},
+			"all_branches": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to include all branches.",
+			},
+			"reporting_window": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The time window to look at. One of 'last-24-hours', 'last-7-days', 'last-30-days', 'last-60-days', 'last-90-days'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(insightsReportingWindows...),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the project.",
+			},
+			"org_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the organization.",
+			},
+			"branches": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The branch names.",
+			},
+		},
+	}
+}
+
+func (d *InsightsBranchesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InsightsBranchesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InsightsBranchesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slug := EscapeProjectSlug(data.ProjectSlug.ValueString())
+	endpoint := fmt.Sprintf("/insights/%s/branches", slug)
+	params := insightsQueryParams(data.Branch, data.AllBranches, data.ReportingWindow)
+
+	if !data.WorkflowName.IsNull() && !data.WorkflowName.IsUnknown() {
+		params["workflow-name"] = data.WorkflowName.ValueString()
+	}
+
+	// The branches endpoint does not use the standard "items" envelope, so it
+	// is paged by hand.
+	result := InsightsBranchesResponse{Branches: []string{}}
+	for {
+		var page InsightsBranchesResponse
+		if err := d.client.Get(ctx, BuildURL(endpoint, params), &page); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read insights branches, got error: %s", err))
+			return
+		}
+
+		result.OrgID = page.OrgID
+		result.ProjectID = page.ProjectID
+		result.Branches = append(result.Branches, page.Branches...)
+
+		if page.NextPageToken == "" {
+			break
+		}
+		params["page-token"] = page.NextPageToken
+	}
+
+	branches, diags := types.ListValueFrom(ctx, types.StringType, result.Branches)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.OrgID = types.StringValue(result.OrgID)
+	data.ProjectID = types.StringValue(result.ProjectID)
+	data.Branches = branches
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
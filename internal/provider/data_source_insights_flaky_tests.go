@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &InsightsFlakyTestsDataSource{}
+
+func NewInsightsFlakyTestsDataSource() datasource.DataSource {
+	return &InsightsFlakyTestsDataSource{}
+}
+
+type InsightsFlakyTestsDataSource struct {
+	client *CircleCIClient
+}
+
+type InsightsFlakyTestsDataSourceModel struct {
+	ProjectSlug     types.String                 `tfsdk:"project_slug"`
+	Branch          types.String                 `tfsdk:"branch"`
+	AllBranches     types.Bool                   `tfsdk:"all_branches"`
+	ReportingWindow types.String                 `tfsdk:"reporting_window"`
+	TotalFlakyTests types.Int64                  `tfsdk:"total_flaky_tests"`
+	FlakyTests      []InsightsFlakyTestDataModel `tfsdk:"flaky_tests"`
+}
+
+type InsightsFlakyTestDataModel struct {
+	TestName          types.String `tfsdk:"test_name"`
+	Classname         types.String `tfsdk:"classname"`
+	File              types.String `tfsdk:"file"`
+	Source            types.String `tfsdk:"source"`
+	JobName           types.String `tfsdk:"job_name"`
+	JobNumber         types.Int64  `tfsdk:"job_number"`
+	WorkflowName      types.String `tfsdk:"workflow_name"`
+	WorkflowID        types.String `tfsdk:"workflow_id"`
+	WorkflowCreatedAt types.String `tfsdk:"workflow_created_at"`
+	PipelineNumber    types.Int64  `tfsdk:"pipeline_number"`
+	TimesFlaked       types.Int64  `tfsdk:"times_flaked"`
+	TimeWasted        types.Int64  `tfsdk:"time_wasted"`
+}
+
+// CircleCI API models for flaky tests
+type InsightsFlakyTestAPI struct {
+	TestName          string `json:"test_name"`
+	Classname         string `json:"classname"`
+	File              string `json:"file"`
+	Source            string `json:"source"`
+	JobName           string `json:"job_name"`
+	JobNumber         int64  `json:"job_number"`
+	WorkflowName      string `json:"workflow_name"`
+	WorkflowID        string `json:"workflow_id"`
+	WorkflowCreatedAt string `json:"workflow_created_at"`
+	PipelineNumber    int64  `json:"pipeline_number"`
+	TimesFlaked       int64  `json:"times_flaked"`
+	TimeWasted        int64  `json:"time_wasted"`
+}
+
+type InsightsFlakyTestsResponse struct {
+	FlakyTests      []InsightsFlakyTestAPI `json:"flaky_tests"`
+	TotalFlakyTests int64                  `json:"total_flaky_tests"`
+	NextPageToken   string                 `json:"next_page_token,omitempty"`
+}
+
+func (d *InsightsFlakyTestsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_insights_flaky_tests"
+}
+
+func (d *InsightsFlakyTestsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Insights Flaky Tests data source. Retrieves the tests that both passed and failed on the same commit.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_slug": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The project slug in the form 'vcs-slug/org-name/repo-name'.",
+			},
+			"branch": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The branch to get flaky tests for. Defaults to the project's default branch.",
+			},
+			"all_branches": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to report flaky tests across all branches.",
+			},
+			"reporting_window": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The time window used to detect flaky tests. One of 'last-24-hours', 'last-7-days', 'last-30-days', 'last-60-days', 'last-90-days'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(insightsReportingWindows...),
+				},
+			},
+			"total_flaky_tests": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The total number of flaky tests.",
+			},
+			"flaky_tests": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of flaky tests.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"test_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the test.",
+						},
+						"classname": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The class the test belongs to.",
+						},
+						"file": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The file the test belongs to.",
+						},
+						"source": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The source of the test results.",
+						},
+						"job_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the job the test last flaked in.",
+						},
+						"job_number": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of the job the test last flaked in.",
+						},
+						"workflow_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the workflow the test last flaked in.",
+						},
+						"workflow_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the workflow the test last flaked in.",
+						},
+						"workflow_created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The date and time the workflow was created.",
+						},
+						"pipeline_number": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of the pipeline the test last flaked in.",
+						},
+						"times_flaked": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of times the test flaked.",
+						},
+						"time_wasted": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The time wasted by the flaky test, in seconds.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *InsightsFlakyTestsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InsightsFlakyTestsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InsightsFlakyTestsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slug := EscapeProjectSlug(data.ProjectSlug.ValueString())
+	endpoint := fmt.Sprintf("/insights/%s/flaky-tests", slug)
+	params := insightsQueryParams(data.Branch, data.AllBranches, data.ReportingWindow)
+
+	// The flaky tests endpoint does not use the standard "items" envelope, so
+	// it is paged by hand.
+	var flakyTests []InsightsFlakyTestAPI
+	var total int64
+	for {
+		var page InsightsFlakyTestsResponse
+		if err := d.client.Get(ctx, BuildURL(endpoint, params), &page); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read flaky tests, got error: %s", err))
+			return
+		}
+
+		flakyTests = append(flakyTests, page.FlakyTests...)
+		total = page.TotalFlakyTests
+
+		if page.NextPageToken == "" {
+			break
+		}
+		params["page-token"] = page.NextPageToken
+	}
+
+	data.TotalFlakyTests = types.Int64Value(total)
+	data.FlakyTests = make([]InsightsFlakyTestDataModel, len(flakyTests))
+	for i, test := range flakyTests {
+		data.FlakyTests[i] = InsightsFlakyTestDataModel{
+			TestName:          types.StringValue(test.TestName),
+			Classname:         types.StringValue(test.Classname),
+			File:              types.StringValue(test.File),
+			Source:            types.StringValue(test.Source),
+			JobName:           types.StringValue(test.JobName),
+			JobNumber:         types.Int64Value(test.JobNumber),
+			WorkflowName:      types.StringValue(test.WorkflowName),
+			WorkflowID:        types.StringValue(test.WorkflowID),
+			WorkflowCreatedAt: types.StringValue(test.WorkflowCreatedAt),
+			PipelineNumber:    types.Int64Value(test.PipelineNumber),
+			TimesFlaked:       types.Int64Value(test.TimesFlaked),
+			TimeWasted:        types.Int64Value(test.TimeWasted),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
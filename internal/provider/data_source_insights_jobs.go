@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &InsightsJobsDataSource{}
+
+func NewInsightsJobsDataSource() datasource.DataSource {
+	return &InsightsJobsDataSource{}
+}
+
+type InsightsJobsDataSource struct {
+	client *CircleCIClient
+}
+
+type InsightsJobsDataSourceModel struct {
+	ProjectSlug     types.String           `tfsdk:"project_slug"`
+	WorkflowName    types.String           `tfsdk:"workflow_name"`
+	Branch          types.String           `tfsdk:"branch"`
+	AllBranches     types.Bool             `tfsdk:"all_branches"`
+	ReportingWindow types.String           `tfsdk:"reporting_window"`
+	Jobs            []InsightsJobDataModel `tfsdk:"jobs"`
+}
+
+type InsightsJobDataModel struct {
+	Name             types.String                 `tfsdk:"name"`
+	WindowStart      types.String                 `tfsdk:"window_start"`
+	WindowEnd        types.String                 `tfsdk:"window_end"`
+	TotalRuns        types.Int64                  `tfsdk:"total_runs"`
+	SuccessfulRuns   types.Int64                  `tfsdk:"successful_runs"`
+	FailedRuns       types.Int64                  `tfsdk:"failed_runs"`
+	SuccessRate      types.Float64                `tfsdk:"success_rate"`
+	Throughput       types.Float64                `tfsdk:"throughput"`
+	TotalCreditsUsed types.Int64                  `tfsdk:"total_credits_used"`
+	DurationMetrics  InsightsDurationMetricsModel `tfsdk:"duration_metrics"`
+}
+
+// CircleCI API models for job insights
+type InsightsJobAPI struct {
+	Name        string `json:"name"`
+	WindowStart string `json:"window_start"`
+	WindowEnd   string `json:"window_end"`
+	Metrics     struct {
+		TotalRuns        int64                      `json:"total_runs"`
+		SuccessfulRuns   int64                      `json:"successful_runs"`
+		FailedRuns       int64                      `json:"failed_runs"`
+		SuccessRate      float64                    `json:"success_rate"`
+		Throughput       float64                    `json:"throughput"`
+		TotalCreditsUsed int64                      `json:"total_credits_used"`
+		DurationMetrics  InsightsDurationMetricsAPI `json:"duration_metrics"`
+	} `json:"metrics"`
+}
+
+func (d *InsightsJobsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_insights_jobs"
+}
+
+func (d *InsightsJobsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Insights Jobs data source. Retrieves duration percentiles, success rates and credit usage for every job of a workflow.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_slug": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The project slug in the form 'vcs-slug/org-name/repo-name'.",
+			},
+			"workflow_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the workflow whose jobs to report on.",
+			},
+			"branch": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The branch to get metrics for. Defaults to the project's default branch.",
+			},
+			"all_branches": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to aggregate metrics across all branches.",
+			},
+			"reporting_window": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The time window used to calculate metrics. One of 'last-24-hours', 'last-7-days', 'last-30-days', 'last-60-days', 'last-90-days'. Defaults to 'last-90-days'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(insightsReportingWindows...),
+				},
+			},
+			"jobs": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Metrics for each job of the workflow.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the job.",
+						},
+						"window_start": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The start of the aggregation window.",
+						},
+						"window_end": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The end of the aggregation window.",
+						},
+						"total_runs": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The total number of runs.",
+						},
+						"successful_runs": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of successful runs.",
+						},
+						"failed_runs": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of failed runs.",
+						},
+						"success_rate": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ratio of successful runs to total runs (0.0 to 1.0).",
+						},
+						"throughput": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The average number of runs per day.",
+						},
+						"total_credits_used": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The total credits consumed by the job.",
+						},
+						"duration_metrics": insightsDurationMetricsSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *InsightsJobsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InsightsJobsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InsightsJobsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slug := EscapeProjectSlug(data.ProjectSlug.ValueString())
+	endpoint := fmt.Sprintf("/insights/%s/workflows/%s/jobs", slug, url.PathEscape(data.WorkflowName.ValueString()))
+	params := insightsQueryParams(data.Branch, data.AllBranches, data.ReportingWindow)
+
+	jobs, err := GetAllPagesOf[InsightsJobAPI](ctx, d.client, endpoint, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read job insights, got error: %s", err))
+		return
+	}
+
+	data.Jobs = make([]InsightsJobDataModel, len(jobs))
+	for i, job := range jobs {
+		data.Jobs[i] = InsightsJobDataModel{
+			Name:             types.StringValue(job.Name),
+			WindowStart:      types.StringValue(job.WindowStart),
+			WindowEnd:        types.StringValue(job.WindowEnd),
+			TotalRuns:        types.Int64Value(job.Metrics.TotalRuns),
+			SuccessfulRuns:   types.Int64Value(job.Metrics.SuccessfulRuns),
+			FailedRuns:       types.Int64Value(job.Metrics.FailedRuns),
+			SuccessRate:      types.Float64Value(job.Metrics.SuccessRate),
+			Throughput:       types.Float64Value(job.Metrics.Throughput),
+			TotalCreditsUsed: types.Int64Value(job.Metrics.TotalCreditsUsed),
+			DurationMetrics:  newInsightsDurationMetricsModel(job.Metrics.DurationMetrics),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
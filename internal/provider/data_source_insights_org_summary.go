@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &InsightsOrgSummaryDataSource{}
+
+func NewInsightsOrgSummaryDataSource() datasource.DataSource {
+	return &InsightsOrgSummaryDataSource{}
+}
+
+type InsightsOrgSummaryDataSource struct {
+	client *CircleCIClient
+}
+
+type InsightsOrgSummaryDataSourceModel struct {
+	OrgSlug         types.String                     `tfsdk:"org_slug"`
+	ReportingWindow types.String                     `tfsdk:"reporting_window"`
+	ProjectNames    types.List                       `tfsdk:"project_names"`
+	Metrics         InsightsOrgMetricsModel          `tfsdk:"metrics"`
+	Trends          InsightsOrgTrendsModel           `tfsdk:"trends"`
+	Projects        []InsightsOrgProjectSummaryModel `tfsdk:"projects"`
+	AllProjects     types.List                       `tfsdk:"all_projects"`
+}
+
+type InsightsOrgMetricsModel struct {
+	TotalRuns         types.Int64   `tfsdk:"total_runs"`
+	TotalDurationSecs types.Int64   `tfsdk:"total_duration_secs"`
+	TotalCreditsUsed  types.Int64   `tfsdk:"total_credits_used"`
+	SuccessRate       types.Float64 `tfsdk:"success_rate"`
+	Throughput        types.Float64 `tfsdk:"throughput"`
+}
+
+// InsightsOrgTrendsModel holds the ratio of each metric against the previous
+// reporting window.
+type InsightsOrgTrendsModel struct {
+	TotalRuns         types.Float64 `tfsdk:"total_runs"`
+	TotalDurationSecs types.Float64 `tfsdk:"total_duration_secs"`
+	TotalCreditsUsed  types.Float64 `tfsdk:"total_credits_used"`
+	SuccessRate       types.Float64 `tfsdk:"success_rate"`
+	Throughput        types.Float64 `tfsdk:"throughput"`
+}
+
+type InsightsOrgProjectSummaryModel struct {
+	ProjectName types.String                `tfsdk:"project_name"`
+	Metrics     InsightsProjectMetricsModel `tfsdk:"metrics"`
+	Trends      InsightsProjectTrendsModel  `tfsdk:"trends"`
+}
+
+type InsightsProjectMetricsModel struct {
+	TotalRuns         types.Int64   `tfsdk:"total_runs"`
+	TotalDurationSecs types.Int64   `tfsdk:"total_duration_secs"`
+	TotalCreditsUsed  types.Int64   `tfsdk:"total_credits_used"`
+	SuccessRate       types.Float64 `tfsdk:"success_rate"`
+}
+
+type InsightsProjectTrendsModel struct {
+	TotalRuns         types.Float64 `tfsdk:"total_runs"`
+	TotalDurationSecs types.Float64 `tfsdk:"total_duration_secs"`
+	TotalCreditsUsed  types.Float64 `tfsdk:"total_credits_used"`
+	SuccessRate       types.Float64 `tfsdk:"success_rate"`
+}
+
+// CircleCI API models for the organization insights summary
+type InsightsOrgSummaryResponse struct {
+	OrgData struct {
+		Metrics InsightsOrgMetricsAPI `json:"metrics"`
+		Trends  InsightsOrgMetricsAPI `json:"trends"`
+	} `json:"org_data"`
+	OrgProjectData []struct {
+		ProjectName string                `json:"project_name"`
+		Metrics     InsightsOrgMetricsAPI `json:"metrics"`
+		Trends      InsightsOrgMetricsAPI `json:"trends"`
+	} `json:"org_project_data"`
+	AllProjects []string `json:"all_projects"`
+}
+
+// InsightsOrgMetricsAPI holds summary metrics. Trends use the same shape and
+// are expressed as ratios against the previous reporting window.
+type InsightsOrgMetricsAPI struct {
+	TotalRuns         float64 `json:"total_runs"`
+	TotalDurationSecs float64 `json:"total_duration_secs"`
+	TotalCreditsUsed  float64 `json:"total_credits_used"`
+	SuccessRate       float64 `json:"success_rate"`
+	Throughput        float64 `json:"throughput"`
+}
+
+func (d *InsightsOrgSummaryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_insights_org_summary"
+}
+
+func (d *InsightsOrgSummaryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Insights Organization Summary data source. Retrieves credit consumption and success rates across all projects of an organization, with trends against the previous reporting window.",
+
+		Attributes: map[string]schema.Attribute{
+			"org_slug": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The organization slug in the form 'vcs-slug/org-name' (e.g. 'gh/acme').",
+			},
+			"reporting_window": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The time window used to calculate metrics. One of 'last-24-hours', 'last-7-days', 'last-30-days', 'last-60-days', 'last-90-days'. Defaults to 'last-90-days'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(insightsReportingWindows...),
+				},
+			},
+			"project_names": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Only include these projects in the summary. Defaults to all projects.",
+			},
+			"metrics": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Organization-level metrics for the reporting window.",
+				Attributes:          insightsOrgMetricsAttributes(),
+			},
+			"trends": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Organization-level metrics relative to the previous reporting window.",
+				Attributes:          insightsOrgTrendsAttributes(),
+			},
+			"projects": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Per-project metrics.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"project_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the project.",
+						},
+						"metrics": schema.SingleNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Project metrics for the reporting window.",
+							Attributes:          insightsProjectMetricsAttributes(),
+						},
+						"trends": schema.SingleNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Project metrics relative to the previous reporting window.",
+							Attributes:          insightsProjectTrendsAttributes(),
+						},
+					},
+				},
+			},
+			"all_projects": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of all projects in the organization, regardless of the project filter.",
+			},
+		},
+	}
+}
+
+func insightsProjectMetricsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"total_runs": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The total number of workflow runs.",
+		},
+		"total_duration_secs": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The total duration of workflow runs in seconds.",
+		},
+		"total_credits_used": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The total credits consumed.",
+		},
+		"success_rate": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The ratio of successful runs to total runs.",
+		},
+	}
+}
+
+func insightsOrgMetricsAttributes() map[string]schema.Attribute {
+	attributes := insightsProjectMetricsAttributes()
+	attributes["throughput"] = schema.Float64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The average number of runs per day.",
+	}
+	return attributes
+}
+
+func insightsProjectTrendsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"total_runs": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The change in the number of workflow runs.",
+		},
+		"total_duration_secs": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The change in total duration.",
+		},
+		"total_credits_used": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The change in credits consumed.",
+		},
+		"success_rate": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The change in success rate.",
+		},
+	}
+}
+
+func insightsOrgTrendsAttributes() map[string]schema.Attribute {
+	attributes := insightsProjectTrendsAttributes()
+	attributes["throughput"] = schema.Float64Attribute{
+		Computed:            true,
+		MarkdownDescription: "The change in throughput.",
+	}
+	return attributes
+}
+
+func (d *InsightsOrgSummaryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InsightsOrgSummaryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InsightsOrgSummaryDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// project-names may be repeated, so the query is built without BuildURL.
+	query := url.Values{}
+
+	if !data.ReportingWindow.IsNull() && !data.ReportingWindow.IsUnknown() {
+		query.Set("reporting-window", data.ReportingWindow.ValueString())
+	}
+
+	if !data.ProjectNames.IsNull() && !data.ProjectNames.IsUnknown() {
+		var projectNames []string
+		resp.Diagnostics.Append(data.ProjectNames.ElementsAs(ctx, &projectNames, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, name := range projectNames {
+			query.Add("project-names", name)
+		}
+	}
+
+	endpoint := fmt.Sprintf("/insights/%s/summary", EscapeProjectSlug(data.OrgSlug.ValueString()))
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var summary InsightsOrgSummaryResponse
+	if err := d.client.Get(ctx, endpoint, &summary); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization insights summary, got error: %s", err))
+		return
+	}
+
+	data.Metrics = newInsightsOrgMetricsModel(summary.OrgData.Metrics)
+	data.Trends = InsightsOrgTrendsModel{
+		TotalRuns:         types.Float64Value(summary.OrgData.Trends.TotalRuns),
+		TotalDurationSecs: types.Float64Value(summary.OrgData.Trends.TotalDurationSecs),
+		TotalCreditsUsed:  types.Float64Value(summary.OrgData.Trends.TotalCreditsUsed),
+		SuccessRate:       types.Float64Value(summary.OrgData.Trends.SuccessRate),
+		Throughput:        types.Float64Value(summary.OrgData.Trends.Throughput),
+	}
+
+	data.Projects = make([]InsightsOrgProjectSummaryModel, len(summary.OrgProjectData))
+	for i, project := range summary.OrgProjectData {
+		data.Projects[i] = InsightsOrgProjectSummaryModel{
+			ProjectName: types.StringValue(project.ProjectName),
+			Metrics:     newInsightsProjectMetricsModel(project.Metrics),
+			Trends: InsightsProjectTrendsModel{
+				TotalRuns:         types.Float64Value(project.Trends.TotalRuns),
+				TotalDurationSecs: types.Float64Value(project.Trends.TotalDurationSecs),
+				TotalCreditsUsed:  types.Float64Value(project.Trends.TotalCreditsUsed),
+				SuccessRate:       types.Float64Value(project.Trends.SuccessRate),
+			},
+		}
+	}
+
+	if summary.AllProjects == nil {
+		summary.AllProjects = []string{}
+	}
+	allProjects, diags := types.ListValueFrom(ctx, types.StringType, summary.AllProjects)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.AllProjects = allProjects
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func newInsightsOrgMetricsModel(m InsightsOrgMetricsAPI) InsightsOrgMetricsModel {
+	return InsightsOrgMetricsModel{
+		TotalRuns:         types.Int64Value(int64(m.TotalRuns)),
+		TotalDurationSecs: types.Int64Value(int64(m.TotalDurationSecs)),
+		TotalCreditsUsed:  types.Int64Value(int64(m.TotalCreditsUsed)),
+		SuccessRate:       types.Float64Value(m.SuccessRate),
+		Throughput:        types.Float64Value(m.Throughput),
+	}
+}
+
+func newInsightsProjectMetricsModel(m InsightsOrgMetricsAPI) InsightsProjectMetricsModel {
+	return InsightsProjectMetricsModel{
+		TotalRuns:         types.Int64Value(int64(m.TotalRuns)),
+		TotalDurationSecs: types.Int64Value(int64(m.TotalDurationSecs)),
+		TotalCreditsUsed:  types.Int64Value(int64(m.TotalCreditsUsed)),
+		SuccessRate:       types.Float64Value(m.SuccessRate),
+	}
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &InsightsWorkflowsDataSource{}
+
+func NewInsightsWorkflowsDataSource() datasource.DataSource {
+	return &InsightsWorkflowsDataSource{}
+}
+
+type InsightsWorkflowsDataSource struct {
+	client *CircleCIClient
+}
+
+type InsightsWorkflowsDataSourceModel struct {
+	ProjectSlug     types.String                `tfsdk:"project_slug"`
+	Branch          types.String                `tfsdk:"branch"`
+	AllBranches     types.Bool                  `tfsdk:"all_branches"`
+	ReportingWindow types.String                `tfsdk:"reporting_window"`
+	Workflows       []InsightsWorkflowDataModel `tfsdk:"workflows"`
+}
+
+type InsightsWorkflowDataModel struct {
+	Name             types.String                 `tfsdk:"name"`
+	ProjectID        types.String                 `tfsdk:"project_id"`
+	WindowStart      types.String                 `tfsdk:"window_start"`
+	WindowEnd        types.String                 `tfsdk:"window_end"`
+	TotalRuns        types.Int64                  `tfsdk:"total_runs"`
+	SuccessfulRuns   types.Int64                  `tfsdk:"successful_runs"`
+	FailedRuns       types.Int64                  `tfsdk:"failed_runs"`
+	SuccessRate      types.Float64                `tfsdk:"success_rate"`
+	Throughput       types.Float64                `tfsdk:"throughput"`
+	MTTR             types.Int64                  `tfsdk:"mttr"`
+	TotalRecoveries  types.Int64                  `tfsdk:"total_recoveries"`
+	TotalCreditsUsed types.Int64                  `tfsdk:"total_credits_used"`
+	DurationMetrics  InsightsDurationMetricsModel `tfsdk:"duration_metrics"`
+}
+
+// CircleCI API models for workflow insights
+type InsightsWorkflowAPI struct {
+	Name        string `json:"name"`
+	ProjectID   string `json:"project_id"`
+	WindowStart string `json:"window_start"`
+	WindowEnd   string `json:"window_end"`
+	Metrics     struct {
+		TotalRuns        int64                      `json:"total_runs"`
+		SuccessfulRuns   int64                      `json:"successful_runs"`
+		FailedRuns       int64                      `json:"failed_runs"`
+		SuccessRate      float64                    `json:"success_rate"`
+		Throughput       float64                    `json:"throughput"`
+		MTTR             int64                      `json:"mttr"`
+		TotalRecoveries  int64                      `json:"total_recoveries"`
+		TotalCreditsUsed int64                      `json:"total_credits_used"`
+		DurationMetrics  InsightsDurationMetricsAPI `json:"duration_metrics"`
+	} `json:"metrics"`
+}
+
+func (d *InsightsWorkflowsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_insights_workflows"
+}
+
+func (d *InsightsWorkflowsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Insights Workflows data source. Retrieves aggregated metrics for every workflow of a project over a reporting window.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_slug": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The project slug in the form 'vcs-slug/org-name/repo-name'.",
+			},
+			"branch": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The branch to get metrics for. Defaults to the project's default branch.",
+			},
+			"all_branches": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to aggregate metrics across all branches.",
+			},
+			"reporting_window": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The time window used to calculate metrics. One of 'last-24-hours', 'last-7-days', 'last-30-days', 'last-60-days', 'last-90-days'. Defaults to 'last-90-days'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(insightsReportingWindows...),
+				},
+			},
+			"workflows": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Metrics for each workflow of the project.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the workflow.",
+						},
+						"project_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the project.",
+						},
+						"window_start": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The start of the aggregation window.",
+						},
+						"window_end": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The end of the aggregation window.",
+						},
+						"total_runs": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The total number of runs.",
+						},
+						"successful_runs": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of successful runs.",
+						},
+						"failed_runs": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of failed runs.",
+						},
+						"success_rate": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The ratio of successful runs to total runs (0.0 to 1.0).",
+						},
+						"throughput": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The average number of runs per day.",
+						},
+						"mttr": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The mean time to recovery in seconds.",
+						},
+						"total_recoveries": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of recoveries from failure.",
+						},
+						"total_credits_used": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The total credits consumed by the workflow.",
+						},
+						"duration_metrics": insightsDurationMetricsSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *InsightsWorkflowsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InsightsWorkflowsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InsightsWorkflowsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slug := EscapeProjectSlug(data.ProjectSlug.ValueString())
+	endpoint := fmt.Sprintf("/insights/%s/workflows", slug)
+	params := insightsQueryParams(data.Branch, data.AllBranches, data.ReportingWindow)
+
+	workflows, err := GetAllPagesOf[InsightsWorkflowAPI](ctx, d.client, endpoint, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow insights, got error: %s", err))
+		return
+	}
+
+	data.Workflows = make([]InsightsWorkflowDataModel, len(workflows))
+	for i, workflow := range workflows {
+		data.Workflows[i] = InsightsWorkflowDataModel{
+			Name:             types.StringValue(workflow.Name),
+			ProjectID:        types.StringValue(workflow.ProjectID),
+			WindowStart:      types.StringValue(workflow.WindowStart),
+			WindowEnd:        types.StringValue(workflow.WindowEnd),
+			TotalRuns:        types.Int64Value(workflow.Metrics.TotalRuns),
+			SuccessfulRuns:   types.Int64Value(workflow.Metrics.SuccessfulRuns),
+			FailedRuns:       types.Int64Value(workflow.Metrics.FailedRuns),
+			SuccessRate:      types.Float64Value(workflow.Metrics.SuccessRate),
+			Throughput:       types.Float64Value(workflow.Metrics.Throughput),
+			MTTR:             types.Int64Value(workflow.Metrics.MTTR),
+			TotalRecoveries:  types.Int64Value(workflow.Metrics.TotalRecoveries),
+			TotalCreditsUsed: types.Int64Value(workflow.Metrics.TotalCreditsUsed),
+			DurationMetrics:  newInsightsDurationMetricsModel(workflow.Metrics.DurationMetrics),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &JobDataSource{}
+
+func NewJobDataSource() datasource.DataSource {
+	return &JobDataSource{}
+}
+
+type JobDataSource struct {
+	client *CircleCIClient
+}
+
+type JobDataSourceModel struct {
+	ProjectSlug    types.String `tfsdk:"project_slug"`
+	JobNumber      types.Int64  `tfsdk:"job_number"`
+	Name           types.String `tfsdk:"name"`
+	Status         types.String `tfsdk:"status"`
+	WebURL         types.String `tfsdk:"web_url"`
+	Parallelism    types.Int64  `tfsdk:"parallelism"`
+	ResourceClass  types.String `tfsdk:"resource_class"`
+	ExecutorType   types.String `tfsdk:"executor_type"`
+	PipelineID     types.String `tfsdk:"pipeline_id"`
+	WorkflowID     types.String `tfsdk:"workflow_id"`
+	WorkflowName   types.String `tfsdk:"workflow_name"`
+	StartedAt      types.String `tfsdk:"started_at"`
+	StoppedAt      types.String `tfsdk:"stopped_at"`
+	DurationMillis types.Int64  `tfsdk:"duration_millis"`
+}
+
+// CircleCI API models for job details
+type JobDetailsAPI struct {
+	Number      int64  `json:"number"`
+	Name        string `json:"name"`
+	Status      string `json:"status"`
+	WebURL      string `json:"web_url"`
+	Parallelism int64  `json:"parallelism"`
+	StartedAt   string `json:"started_at"`
+	StoppedAt   string `json:"stopped_at"`
+	Duration    int64  `json:"duration"`
+	Executor    struct {
+		ResourceClass string `json:"resource_class"`
+		Type          string `json:"type"`
+	} `json:"executor"`
+	Pipeline struct {
+		ID string `json:"id"`
+	} `json:"pipeline"`
+	LatestWorkflow struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"latest_workflow"`
+}
+
+func (d *JobDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job"
+}
+
+func (d *JobDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Job data source. Looks up a job by its number in a project.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_slug": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The project slug in the form 'vcs-slug/org-name/repo-name'.",
+			},
+			"job_number": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The number of the job.",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the job.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the job.",
+			},
+			"web_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL of the job in the CircleCI web app.",
+			},
+			"parallelism": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of parallel nodes the job ran on.",
+			},
+			"resource_class": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource class the job ran on.",
+			},
+			"executor_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The executor type (docker, machine, macos, ...).",
+			},
+			"pipeline_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the pipeline the job belongs to.",
+			},
+			"workflow_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the latest workflow the job ran in.",
+			},
+			"workflow_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the latest workflow the job ran in.",
+			},
+			"started_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date and time the job started.",
+			},
+			"stopped_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date and time the job stopped.",
+			},
+			"duration_millis": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "How long the job ran, in milliseconds.",
+			},
+		},
+	}
+}
+
+func (d *JobDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *JobDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data JobDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := fmt.Sprintf("/project/%s/job/%d", EscapeProjectSlug(data.ProjectSlug.ValueString()), data.JobNumber.ValueInt64())
+
+	var job JobDetailsAPI
+	if err := d.client.Get(ctx, endpoint, &job); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read job, got error: %s", err))
+		return
+	}
+
+	data.Name = types.StringValue(job.Name)
+	data.Status = types.StringValue(job.Status)
+	data.WebURL = types.StringValue(job.WebURL)
+	data.Parallelism = types.Int64Value(job.Parallelism)
+	data.ResourceClass = types.StringValue(job.Executor.ResourceClass)
+	data.ExecutorType = types.StringValue(job.Executor.Type)
+	data.PipelineID = types.StringValue(job.Pipeline.ID)
+	data.WorkflowID = types.StringValue(job.LatestWorkflow.ID)
+	data.WorkflowName = types.StringValue(job.LatestWorkflow.Name)
+	data.StartedAt = types.StringValue(job.StartedAt)
+	data.StoppedAt = types.StringValue(job.StoppedAt)
+	data.DurationMillis = types.Int64Value(job.Duration)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &JobArtifactsDataSource{}
+
+func NewJobArtifactsDataSource() datasource.DataSource {
+	return &JobArtifactsDataSource{}
+}
+
+type JobArtifactsDataSource struct {
+	client *CircleCIClient
+}
+
+type JobArtifactsDataSourceModel struct {
+	ProjectSlug types.String               `tfsdk:"project_slug"`
+	JobNumber   types.Int64                `tfsdk:"job_number"`
+	Pattern     types.String               `tfsdk:"pattern"`
+	DownloadTo  types.String               `tfsdk:"download_to"`
+	Artifacts   []JobArtifactDataItemModel `tfsdk:"artifacts"`
+}
+
+type JobArtifactDataItemModel struct {
+	Path      types.String `tfsdk:"path"`
+	URL       types.String `tfsdk:"url"`
+	NodeIndex types.Int64  `tfsdk:"node_index"`
+	LocalPath types.String `tfsdk:"local_path"`
+}
+
+// CircleCI API models for job artifacts
+type JobArtifactAPI struct {
+	Path      string `json:"path"`
+	URL       string `json:"url"`
+	NodeIndex int64  `json:"node_index"`
+}
+
+func (d *JobArtifactsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_artifacts"
+}
+
+func (d *JobArtifactsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Job Artifacts data source. Lists the artifacts of a job and optionally downloads them.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_slug": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The project slug in the form 'vcs-slug/org-name/repo-name'.",
+			},
+			"job_number": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "The number of the job.",
+			},
+			"pattern": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return artifacts whose path matches this glob pattern, such as 'reports/*.json'. `*` does not match '/'.",
+				Validators: []validator.String{
+					globValidator{},
+				},
+			},
+			"download_to": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A directory to download the returned artifacts to, keeping their paths. Created if missing. When the artifacts come from several parallel nodes, each node's artifacts go in a subdirectory named after its node index.",
+			},
+			"artifacts": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The artifacts of the job.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The path of the artifact.",
+						},
+						"url": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The URL to download the artifact from.",
+						},
+						"node_index": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The index of the parallel node that stored the artifact.",
+						},
+						"local_path": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Where the artifact was downloaded to. Null unless `download_to` is set.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *JobArtifactsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *JobArtifactsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data JobArtifactsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := fmt.Sprintf("/project/%s/%d/artifacts", EscapeProjectSlug(data.ProjectSlug.ValueString()), data.JobNumber.ValueInt64())
+	artifacts, err := GetAllPagesOf[JobArtifactAPI](ctx, d.client, endpoint, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list job artifacts, got error: %s", err))
+		return
+	}
+
+	artifacts = filterArtifacts(artifacts, data.Pattern.ValueString())
+	severalNodes := artifactsFromSeveralNodes(artifacts)
+
+	data.Artifacts = make([]JobArtifactDataItemModel, len(artifacts))
+	for i, artifact := range artifacts {
+		data.Artifacts[i] = JobArtifactDataItemModel{
+			Path:      types.StringValue(artifact.Path),
+			URL:       types.StringValue(artifact.URL),
+			NodeIndex: types.Int64Value(artifact.NodeIndex),
+			LocalPath: types.StringNull(),
+		}
+
+		if data.DownloadTo.IsNull() {
+			continue
+		}
+
+		// Parallel nodes often store the same path; keep their copies apart.
+		dir := data.DownloadTo.ValueString()
+		if severalNodes {
+			dir = filepath.Join(dir, strconv.FormatInt(artifact.NodeIndex, 10))
+		}
+
+		localPath, err := artifactLocalPath(dir, artifact.Path)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Artifact Path", err.Error())
+			return
+		}
+
+		if err := d.downloadArtifact(ctx, artifact.URL, localPath); err != nil {
+			resp.Diagnostics.AddError("Download Error", fmt.Sprintf("Unable to download artifact %s, got error: %s", artifact.Path, err))
+			return
+		}
+
+		tflog.Debug(ctx, "downloaded job artifact", map[string]interface{}{
+			"path":       artifact.Path,
+			"local_path": localPath,
+		})
+		data.Artifacts[i].LocalPath = types.StringValue(localPath)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// downloadArtifact fetches an artifact into localPath. Artifacts of private
+// projects need the API token.
+func (d *JobArtifactsDataSource) downloadArtifact(ctx context.Context, url, localPath string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Circle-Token", d.client.ApiToken)
+	req.Header.Set("User-Agent", "terraform-provider-circleci")
+
+	resp, err := d.client.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP %d downloading artifact", resp.StatusCode)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(localPath), 0o755); err != nil {
+		return err
+	}
+
+	f, err := os.Create(localPath)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// filterArtifacts returns the artifacts whose path matches pattern. An empty
+// pattern matches every artifact.
+func filterArtifacts(artifacts []JobArtifactAPI, pattern string) []JobArtifactAPI {
+	if pattern == "" {
+		return artifacts
+	}
+
+	var matched []JobArtifactAPI
+	for _, artifact := range artifacts {
+		if ok, _ := path.Match(pattern, artifact.Path); ok {
+			matched = append(matched, artifact)
+		}
+	}
+	return matched
+}
+
+// artifactsFromSeveralNodes reports whether the artifacts were stored by more
+// than one parallel node.
+func artifactsFromSeveralNodes(artifacts []JobArtifactAPI) bool {
+	for _, artifact := range artifacts {
+		if artifact.NodeIndex != artifacts[0].NodeIndex {
+			return true
+		}
+	}
+	return false
+}
+
+// artifactLocalPath returns where to store an artifact under dir, refusing
+// paths that would escape it.
+func artifactLocalPath(dir, artifactPath string) (string, error) {
+	localPath := filepath.Join(dir, filepath.FromSlash(strings.TrimPrefix(path.Clean("/"+artifactPath), "/")))
+
+	rel, err := filepath.Rel(dir, localPath)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("artifact path %q does not resolve to a file under %s", artifactPath, dir)
+	}
+
+	return localPath, nil
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestFilterArtifacts(t *testing.T) {
+	artifacts := []JobArtifactAPI{
+		{Path: "reports/unit.json"},
+		{Path: "reports/coverage/index.html"},
+		{Path: "sbom.spdx.json"},
+	}
+
+	tests := []struct {
+		pattern string
+		want    []string
+	}{
+		{pattern: "", want: []string{"reports/unit.json", "reports/coverage/index.html", "sbom.spdx.json"}},
+		{pattern: "reports/*.json", want: []string{"reports/unit.json"}},
+		{pattern: "*.json", want: []string{"sbom.spdx.json"}},
+		{pattern: "*.tar", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			var got []string
+			for _, artifact := range filterArtifacts(artifacts, tt.pattern) {
+				got = append(got, artifact.Path)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filterArtifacts(%q) = %v, want %v", tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArtifactLocalPath(t *testing.T) {
+	dir := t.TempDir()
+
+	got, err := artifactLocalPath(dir, "reports/unit.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join(dir, "reports", "unit.json"); got != want {
+		t.Errorf("artifactLocalPath() = %q, want %q", got, want)
+	}
+
+	// Traversal is confined to the download directory.
+	got, err = artifactLocalPath(dir, "../../etc/passwd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join(dir, "etc", "passwd"); got != want {
+		t.Errorf("artifactLocalPath() = %q, want %q", got, want)
+	}
+
+	if _, err := artifactLocalPath(dir, "/"); err == nil {
+		t.Error("expected an error for an artifact without a file name")
+	}
+}
+
+func TestArtifactsFromSeveralNodes(t *testing.T) {
+	single := []JobArtifactAPI{{Path: "a", NodeIndex: 1}, {Path: "b", NodeIndex: 1}}
+	if artifactsFromSeveralNodes(single) {
+		t.Error("artifactsFromSeveralNodes() = true for artifacts of one node")
+	}
+
+	parallel := []JobArtifactAPI{{Path: "test-results/junit.xml", NodeIndex: 0}, {Path: "test-results/junit.xml", NodeIndex: 1}}
+	if !artifactsFromSeveralNodes(parallel) {
+		t.Error("artifactsFromSeveralNodes() = false for artifacts of two nodes")
+	}
+
+	if artifactsFromSeveralNodes(nil) {
+		t.Error("artifactsFromSeveralNodes() = true for no artifacts")
+	}
+}
@@ -3,10 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ datasource.DataSource = &OrganizationDataSource{}
@@ -20,12 +26,18 @@ type OrganizationDataSource struct {
 }
 
 type OrganizationDataSourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	Slug      types.String `tfsdk:"slug"`
-	VcsType   types.String `tfsdk:"vcs_type"`
-	AvatarURL types.String `tfsdk:"avatar_url"`
-	CreatedAt types.String `tfsdk:"created_at"`
+	ID        types.String           `tfsdk:"id"`
+	Name      types.String           `tfsdk:"name"`
+	Slug      types.String           `tfsdk:"slug"`
+	VcsType   types.String           `tfsdk:"vcs_type"`
+	AvatarURL types.String           `tfsdk:"avatar_url"`
+	CreatedAt types.String           `tfsdk:"created_at"`
+	Plan      *OrganizationPlanModel `tfsdk:"plan"`
+}
+
+type OrganizationPlanModel struct {
+	ResourceClasses types.List `tfsdk:"resource_classes"`
+	Features        types.Map  `tfsdk:"features"`
 }
 
 // CircleCI API models for organizations
@@ -38,28 +50,102 @@ type Organization struct {
 	CreatedAt string `json:"created_at"`
 }
 
+// readOrganizationPlan reads the capabilities of an organization from the
+// APIs that expose them. A capability the token may not read (403 or 404) is
+// left out instead of failing the lookup: null resource classes, or a
+// missing key in features.
+func readOrganizationPlan(ctx context.Context, c *CircleCIClient, org Organization) (*OrganizationPlanModel, error) {
+	features := map[string]attr.Value{}
+	plan := &OrganizationPlanModel{
+		ResourceClasses: types.ListNull(types.StringType),
+	}
+
+	// Self-hosted runner resource classes live in a namespace named after
+	// the organization.
+	resourceClasses, err := listRunnerResourceClasses(ctx, c, org.Name)
+	switch {
+	case err == nil:
+		names := make([]attr.Value, len(resourceClasses))
+		for i, rc := range resourceClasses {
+			names[i] = types.StringValue(rc.ResourceClass)
+		}
+		plan.ResourceClasses = types.ListValueMust(types.StringType, names)
+		features["runner"] = types.BoolValue(len(resourceClasses) > 0)
+	case IsNotFound(err) || IsForbidden(err):
+		tflog.Debug(ctx, "cannot read runner resource classes of organization", map[string]interface{}{
+			"org_id": org.ID,
+			"error":  err.Error(),
+		})
+	default:
+		return nil, fmt.Errorf("unable to list runner resource classes: %w", err)
+	}
+
+	var policySettings PolicySettingsAPI
+	err = c.Get(ctx, policySettingsEndpoint(org.ID, defaultPolicyContext), &policySettings)
+	switch {
+	case err == nil:
+		features["config_policies"] = types.BoolValue(policySettings.Enabled)
+	case IsNotFound(err) || IsForbidden(err):
+		tflog.Debug(ctx, "cannot read policy settings of organization", map[string]interface{}{
+			"org_id": org.ID,
+			"error":  err.Error(),
+		})
+	default:
+		return nil, fmt.Errorf("unable to read policy settings: %w", err)
+	}
+
+	plan.Features = types.MapValueMust(types.BoolType, features)
+	return plan, nil
+}
+
+// vcsSlugPrefixes maps the long VCS names accepted in organization slugs to
+// the short form CircleCI returns.
+var vcsSlugPrefixes = map[string]string{
+	"github":    "gh",
+	"bitbucket": "bb",
+}
+
+// normalizeOrgSlug returns the canonical form of an organization slug so that
+// 'github/Acme' and 'gh/acme' compare equal. Slugs are case-insensitive.
+func normalizeOrgSlug(slug string) string {
+	slug = strings.ToLower(strings.Trim(slug, "/"))
+
+	vcs, rest, found := strings.Cut(slug, "/")
+	if !found {
+		return slug
+	}
+	if short, ok := vcsSlugPrefixes[vcs]; ok {
+		vcs = short
+	}
+	return vcs + "/" + rest
+}
+
 func (d *OrganizationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_organization"
 }
 
 func (d *OrganizationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "CircleCI Organization data source. Use this data source to get information about an organization.",
+		MarkdownDescription: "CircleCI Organization data source. Use this data source to get information about an organization. Lookups by name or slug only see the organizations the API token collaborates on.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The unique identifier of the organization. Either 'id' or 'name' must be specified.",
+				MarkdownDescription: "The unique identifier of the organization. Exactly one of 'id', 'name' or 'slug' must be specified.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name"), path.MatchRoot("slug")),
+				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the organization. Either 'id' or 'name' must be specified.",
+				MarkdownDescription: "The name of the organization. Exactly one of 'id', 'name' or 'slug' must be specified.",
 				Optional:            true,
 				Computed:            true,
 			},
 			"slug": schema.StringAttribute{
+				MarkdownDescription: "The slug of the organization, such as 'gh/acme', 'github/acme' or 'circleci/<uuid>'. Matched case-insensitively. Exactly one of 'id', 'name' or 'slug' must be specified.",
+				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "The slug of the organization.",
 			},
 			"vcs_type": schema.StringAttribute{
 				Computed:            true,
@@ -71,7 +157,23 @@ func (d *OrganizationDataSource) Schema(ctx context.Context, req datasource.Sche
 			},
 			"created_at": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "The date and time when the organization was created.",
+				MarkdownDescription: "The date and time when the organization was created. Empty when the organization is looked up by name or slug.",
+			},
+			"plan": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Capabilities of the organization that modules can branch on.",
+				Attributes: map[string]schema.Attribute{
+					"resource_classes": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "The self-hosted runner resource classes available to the organization. Null when the API token cannot list them.",
+					},
+					"features": schema.MapAttribute{
+						Computed:            true,
+						ElementType:         types.BoolType,
+						MarkdownDescription: "Whether each feature is enabled: 'runner' (the organization has runner resource classes) and 'config_policies' (config policy decisions are enforced). A feature the API token cannot read is left out.",
+					},
+				},
 			},
 		},
 	}
@@ -110,32 +212,41 @@ func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRe
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization, got error: %s", err))
 			return
 		}
-	} else if !data.Name.IsNull() && !data.Name.IsUnknown() {
-		// Read by name - need to list all organizations and find by name
-		var orgs struct {
-			Items []Organization `json:"items"`
-		}
-		if err := d.client.Get(ctx, "/organization", &orgs); err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list organizations, got error: %s", err))
+	} else {
+		// Read by name or slug - collaborations list every organization the token can see
+		collaborations, err := listCollaborations(ctx, d.client)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list collaborations, got error: %s", err))
 			return
 		}
 
-		var found bool
-		for _, organization := range orgs.Items {
-			if organization.Name == data.Name.ValueString() {
-				org = organization
-				found = true
-				break
-			}
+		var collaboration *CollaborationAPI
+		if !data.Slug.IsNull() {
+			collaboration = findCollaboration(collaborations, func(c CollaborationAPI) bool {
+				return normalizeOrgSlug(c.Slug) == normalizeOrgSlug(data.Slug.ValueString())
+			})
+		} else {
+			collaboration = findCollaboration(collaborations, func(c CollaborationAPI) bool {
+				return c.Name == data.Name.ValueString()
+			})
 		}
 
-		if !found {
-			resp.Diagnostics.AddError("Organization Not Found", fmt.Sprintf("Organization with name '%s' not found", data.Name.ValueString()))
+		if collaboration == nil {
+			if !data.Slug.IsNull() {
+				resp.Diagnostics.AddError("Organization Not Found", fmt.Sprintf("Organization with slug '%s' not found", data.Slug.ValueString()))
+			} else {
+				resp.Diagnostics.AddError("Organization Not Found", fmt.Sprintf("Organization with name '%s' not found", data.Name.ValueString()))
+			}
 			return
 		}
-	} else {
-		resp.Diagnostics.AddError("Missing Required Attribute", "Either 'id' or 'name' must be specified")
-		return
+
+		org = Organization{
+			ID:        collaboration.ID,
+			Name:      collaboration.Name,
+			Slug:      collaboration.Slug,
+			VcsType:   collaboration.VcsType,
+			AvatarURL: collaboration.AvatarURL,
+		}
 	}
 
 	// Update the model with the response
@@ -146,5 +257,12 @@ func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRe
 	data.AvatarURL = types.StringValue(org.AvatarURL)
 	data.CreatedAt = types.StringValue(org.CreatedAt)
 
+	plan, err := readOrganizationPlan(ctx, d.client, org)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization plan, got error: %s", err))
+		return
+	}
+	data.Plan = plan
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizeOrgSlug(t *testing.T) {
+	tests := []struct {
+		slug string
+		want string
+	}{
+		{"gh/acme", "gh/acme"},
+		{"github/Acme", "gh/acme"},
+		{"bitbucket/acme", "bb/acme"},
+		{"BB/acme/", "bb/acme"},
+		{"circleci/9A1B2C3D-0000-4000-8000-000000000000", "circleci/9a1b2c3d-0000-4000-8000-000000000000"},
+		{"acme", "acme"},
+	}
+
+	for _, tt := range tests {
+		if got := normalizeOrgSlug(tt.slug); got != tt.want {
+			t.Errorf("normalizeOrgSlug(%q) = %q, want %q", tt.slug, got, tt.want)
+		}
+	}
+}
+
+func TestReadOrganizationPlan(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path + "?" + r.URL.RawQuery {
+		case "/api/v2/runner/resource?namespace=acme":
+			// Response of GET /runner/resource?namespace={namespace}
+			fmt.Fprint(w, `{"items":[{"id":"rc-1","resource_class":"acme/linux-large","description":"Linux runners"}]}`)
+		case "/api/v2/owner/org-acme/context/config/decision/settings?":
+			// Response of GET /owner/{ownerID}/context/{context}/decision/settings
+			fmt.Fprint(w, `{"enabled":true}`)
+		case "/api/v2/runner/resource?namespace=locked":
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"message":"Forbidden"}`)
+		case "/api/v2/owner/org-locked/context/config/decision/settings?":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message":"Not found"}`)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"message":"Internal error"}`)
+		}
+	}))
+	defer server.Close()
+
+	client := &CircleCIClient{BaseURL: server.URL + "/api/v2", HTTPClient: server.Client()}
+
+	plan, err := readOrganizationPlan(context.Background(), client, Organization{ID: "org-acme", Name: "acme"})
+	if err != nil {
+		t.Fatalf("readOrganizationPlan() error = %v", err)
+	}
+	if got := plan.ResourceClasses.String(); got != `["acme/linux-large"]` {
+		t.Errorf("resource_classes = %s, want [\"acme/linux-large\"]", got)
+	}
+	features := plan.Features.Elements()
+	if !features["runner"].Equal(types.BoolValue(true)) || !features["config_policies"].Equal(types.BoolValue(true)) {
+		t.Errorf("features = %s, want runner and config_policies enabled", plan.Features)
+	}
+
+	// Capabilities the token may not read are left out, not errors.
+	plan, err = readOrganizationPlan(context.Background(), client, Organization{ID: "org-locked", Name: "locked"})
+	if err != nil {
+		t.Fatalf("readOrganizationPlan() error = %v", err)
+	}
+	if !plan.ResourceClasses.IsNull() || len(plan.Features.Elements()) != 0 {
+		t.Errorf("plan = %+v, want null resource classes and no features", plan)
+	}
+
+	if _, err := readOrganizationPlan(context.Background(), client, Organization{ID: "org-broken", Name: "broken"}); err == nil {
+		t.Error("expected an error when the API fails")
+	}
+}
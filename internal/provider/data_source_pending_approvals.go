@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &PendingApprovalsDataSource{}
+
+func NewPendingApprovalsDataSource() datasource.DataSource {
+	return &PendingApprovalsDataSource{}
+}
+
+type PendingApprovalsDataSource struct {
+	client *CircleCIClient
+}
+
+type PendingApprovalsDataSourceModel struct {
+	WorkflowID types.String                   `tfsdk:"workflow_id"`
+	PipelineID types.String                   `tfsdk:"pipeline_id"`
+	Approvals  []PendingApprovalDataItemModel `tfsdk:"approvals"`
+}
+
+type PendingApprovalDataItemModel struct {
+	WorkflowID        types.String `tfsdk:"workflow_id"`
+	WorkflowName      types.String `tfsdk:"workflow_name"`
+	JobName           types.String `tfsdk:"job_name"`
+	ApprovalRequestID types.String `tfsdk:"approval_request_id"`
+}
+
+func (d *PendingApprovalsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pending_approvals"
+}
+
+func (d *PendingApprovalsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Pending Approvals data source. Lists the approval jobs that are on hold, without approving them.",
+
+		Attributes: map[string]schema.Attribute{
+			"workflow_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only look at this workflow. Either `workflow_id` or `pipeline_id` must be specified.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("pipeline_id")),
+				},
+			},
+			"pipeline_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Look at every workflow of this pipeline.",
+			},
+			"approvals": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The approval jobs that are on hold.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"workflow_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the workflow.",
+						},
+						"workflow_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the workflow.",
+						},
+						"job_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the approval job.",
+						},
+						"approval_request_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The approval request ID of the job.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PendingApprovalsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PendingApprovalsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PendingApprovalsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var workflows []WorkflowAPI
+	if !data.WorkflowID.IsNull() {
+		var workflow WorkflowAPI
+		if err := d.client.Get(ctx, fmt.Sprintf("/workflow/%s", data.WorkflowID.ValueString()), &workflow); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow, got error: %s", err))
+			return
+		}
+		workflows = []WorkflowAPI{workflow}
+	} else {
+		var err error
+		workflows, err = GetAllPagesOf[WorkflowAPI](ctx, d.client, fmt.Sprintf("/pipeline/%s/workflow", data.PipelineID.ValueString()), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list pipeline workflows, got error: %s", err))
+			return
+		}
+	}
+
+	data.Approvals = []PendingApprovalDataItemModel{}
+	for _, workflow := range workflows {
+		jobs, err := listWorkflowJobs(ctx, d.client, workflow.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list workflow jobs, got error: %s", err))
+			return
+		}
+
+		for _, job := range jobs {
+			if job.Type != "approval" || job.Status != "on_hold" {
+				continue
+			}
+
+			data.Approvals = append(data.Approvals, PendingApprovalDataItemModel{
+				WorkflowID:        types.StringValue(workflow.ID),
+				WorkflowName:      types.StringValue(workflow.Name),
+				JobName:           types.StringValue(job.Name),
+				ApprovalRequestID: types.StringValue(job.ApprovalRequestID),
+			})
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &PipelineDataSource{}
+
+func NewPipelineDataSource() datasource.DataSource {
+	return &PipelineDataSource{}
+}
+
+type PipelineDataSource struct {
+	client *CircleCIClient
+}
+
+type PipelineDataSourceModel struct {
+	ID          types.String            `tfsdk:"id"`
+	ProjectSlug types.String            `tfsdk:"project_slug"`
+	Number      types.Int64             `tfsdk:"number"`
+	State       types.String            `tfsdk:"state"`
+	Branch      types.String            `tfsdk:"branch"`
+	Tag         types.String            `tfsdk:"tag"`
+	Revision    types.String            `tfsdk:"revision"`
+	TriggerType types.String            `tfsdk:"trigger_type"`
+	ActorLogin  types.String            `tfsdk:"actor_login"`
+	CreatedAt   types.String            `tfsdk:"created_at"`
+	UpdatedAt   types.String            `tfsdk:"updated_at"`
+	Workflows   []WorkflowDataItemModel `tfsdk:"workflows"`
+}
+
+type WorkflowDataItemModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Status    types.String `tfsdk:"status"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	StoppedAt types.String `tfsdk:"stopped_at"`
+}
+
+func (d *PipelineDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline"
+}
+
+func (d *PipelineDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := pipelineAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The unique identifier of the pipeline. Either `id` or `project_slug` and `number` must be specified.",
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("number")),
+		},
+	}
+	attributes["project_slug"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The project slug in the form 'vcs-slug/org-name/repo-name'.",
+	}
+	attributes["number"] = schema.Int64Attribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "The number of the pipeline within the project.",
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRoot("project_slug")),
+		},
+	}
+	attributes["workflows"] = schema.ListNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "The workflows of the pipeline.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The unique identifier of the workflow.",
+				},
+				"name": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The name of the workflow.",
+				},
+				"status": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The status of the workflow.",
+				},
+				"created_at": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The date and time the workflow was created.",
+				},
+				"stopped_at": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The date and time the workflow stopped.",
+				},
+			},
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Pipeline data source. Looks up a pipeline and the status of its workflows.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *PipelineDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PipelineDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PipelineDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := fmt.Sprintf("/pipeline/%s", data.ID.ValueString())
+	if data.ID.IsNull() {
+		endpoint = fmt.Sprintf("/project/%s/pipeline/%d", EscapeProjectSlug(data.ProjectSlug.ValueString()), data.Number.ValueInt64())
+	}
+
+	var pipeline PipelineAPI
+	if err := d.client.Get(ctx, endpoint, &pipeline); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read pipeline, got error: %s", err))
+		return
+	}
+
+	workflows, err := GetAllPagesOf[WorkflowAPI](ctx, d.client, fmt.Sprintf("/pipeline/%s/workflow", pipeline.ID), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list pipeline workflows, got error: %s", err))
+		return
+	}
+
+	item := newPipelineDataItemModel(pipeline)
+	data.ID = item.ID
+	if data.ProjectSlug.IsNull() {
+		data.ProjectSlug = types.StringValue(pipeline.ProjectSlug)
+	}
+	data.Number = item.Number
+	data.State = item.State
+	data.Branch = item.Branch
+	data.Tag = item.Tag
+	data.Revision = item.Revision
+	data.TriggerType = item.TriggerType
+	data.ActorLogin = item.ActorLogin
+	data.CreatedAt = item.CreatedAt
+	data.UpdatedAt = item.UpdatedAt
+
+	data.Workflows = make([]WorkflowDataItemModel, len(workflows))
+	for i, workflow := range workflows {
+		data.Workflows[i] = WorkflowDataItemModel{
+			ID:        types.StringValue(workflow.ID),
+			Name:      types.StringValue(workflow.Name),
+			Status:    types.StringValue(workflow.Status),
+			CreatedAt: types.StringValue(workflow.CreatedAt),
+			StoppedAt: types.StringValue(workflow.StoppedAt),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
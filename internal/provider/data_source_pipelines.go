@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &PipelinesDataSource{}
+
+// pipelinesDefaultLimit bounds how many pipelines are read when no limit is
+// configured; busy projects have thousands.
+const pipelinesDefaultLimit = 20
+
+func NewPipelinesDataSource() datasource.DataSource {
+	return &PipelinesDataSource{}
+}
+
+type PipelinesDataSource struct {
+	client *CircleCIClient
+}
+
+type PipelinesDataSourceModel struct {
+	ProjectSlug types.String            `tfsdk:"project_slug"`
+	Branch      types.String            `tfsdk:"branch"`
+	Mine        types.Bool              `tfsdk:"mine"`
+	Limit       types.Int64             `tfsdk:"limit"`
+	Pipelines   []PipelineDataItemModel `tfsdk:"pipelines"`
+}
+
+type PipelineDataItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Number      types.Int64  `tfsdk:"number"`
+	State       types.String `tfsdk:"state"`
+	Branch      types.String `tfsdk:"branch"`
+	Tag         types.String `tfsdk:"tag"`
+	Revision    types.String `tfsdk:"revision"`
+	TriggerType types.String `tfsdk:"trigger_type"`
+	ActorLogin  types.String `tfsdk:"actor_login"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// pipelineAttributes describes a pipeline; shared by the pipeline data
+// sources.
+func pipelineAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The unique identifier of the pipeline.",
+		},
+		"number": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The number of the pipeline.",
+		},
+		"state": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The state of the pipeline (created, errored, setup-pending, setup, pending).",
+		},
+		"branch": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The branch the pipeline ran on.",
+		},
+		"tag": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The tag the pipeline ran on.",
+		},
+		"revision": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The commit the pipeline ran on.",
+		},
+		"trigger_type": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "What triggered the pipeline (webhook, explicit, api, schedule).",
+		},
+		"actor_login": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The login of the user who triggered the pipeline.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time the pipeline was created.",
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date and time the pipeline was last updated.",
+		},
+	}
+}
+
+func newPipelineDataItemModel(pipeline PipelineAPI) PipelineDataItemModel {
+	return PipelineDataItemModel{
+		ID:          types.StringValue(pipeline.ID),
+		Number:      types.Int64Value(pipeline.Number),
+		State:       types.StringValue(pipeline.State),
+		Branch:      types.StringValue(pipeline.VCS.Branch),
+		Tag:         types.StringValue(pipeline.VCS.Tag),
+		Revision:    types.StringValue(pipeline.VCS.Revision),
+		TriggerType: types.StringValue(pipeline.Trigger.Type),
+		ActorLogin:  types.StringValue(pipeline.Trigger.Actor.Login),
+		CreatedAt:   types.StringValue(pipeline.CreatedAt),
+		UpdatedAt:   types.StringValue(pipeline.UpdatedAt),
+	}
+}
+
+func (d *PipelinesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipelines"
+}
+
+func (d *PipelinesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Pipelines data source. Lists the most recent pipelines of a project, newest first.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_slug": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The project slug in the form 'vcs-slug/org-name/repo-name'.",
+			},
+			"branch": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return pipelines of this branch.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("mine")),
+				},
+			},
+			"mine": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return pipelines triggered by the owner of the API token.",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The maximum number of pipelines to return. Defaults to %d.", pipelinesDefaultLimit),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"pipelines": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The pipelines, newest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: pipelineAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *PipelinesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PipelinesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PipelinesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := int64(pipelinesDefaultLimit)
+	if !data.Limit.IsNull() {
+		limit = data.Limit.ValueInt64()
+	}
+
+	endpoint := fmt.Sprintf("/project/%s/pipeline", EscapeProjectSlug(data.ProjectSlug.ValueString()))
+	params := map[string]string{}
+	if data.Mine.ValueBool() {
+		endpoint += "/mine"
+	} else if !data.Branch.IsNull() {
+		params["branch"] = data.Branch.ValueString()
+	}
+
+	pipelines, err := GetPagesOf[PipelineAPI](ctx, d.client, endpoint, params, int(limit))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list pipelines, got error: %s", err))
+		return
+	}
+
+	data.Pipelines = make([]PipelineDataItemModel, len(pipelines))
+	for i, pipeline := range pipelines {
+		data.Pipelines[i] = newPipelineDataItemModel(pipeline)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
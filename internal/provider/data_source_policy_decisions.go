@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &PolicyDecisionsDataSource{}
+
+// policyDecisionStatuses lists the outcomes of a policy decision.
+var policyDecisionStatuses = []string{"PASS", "SOFT_FAIL", "HARD_FAIL", "ERROR"}
+
+// policyDecisionsDefaultLimit bounds how many decisions are read when no
+// limit is configured; the log of an active organization is large.
+const policyDecisionsDefaultLimit = 100
+
+func NewPolicyDecisionsDataSource() datasource.DataSource {
+	return &PolicyDecisionsDataSource{}
+}
+
+type PolicyDecisionsDataSource struct {
+	client *CircleCIClient
+}
+
+type PolicyDecisionsDataSourceModel struct {
+	OwnerID   types.String                 `tfsdk:"owner_id"`
+	Context   types.String                 `tfsdk:"context"`
+	Status    types.String                 `tfsdk:"status"`
+	Branch    types.String                 `tfsdk:"branch"`
+	ProjectID types.String                 `tfsdk:"project_id"`
+	After     types.String                 `tfsdk:"after"`
+	Before    types.String                 `tfsdk:"before"`
+	Limit     types.Int64                  `tfsdk:"limit"`
+	Decisions []PolicyDecisionLogDataModel `tfsdk:"decisions"`
+}
+
+type PolicyDecisionLogDataModel struct {
+	ID           types.String           `tfsdk:"id"`
+	CreatedAt    types.String           `tfsdk:"created_at"`
+	ProjectID    types.String           `tfsdk:"project_id"`
+	Branch       types.String           `tfsdk:"branch"`
+	BuildNumber  types.String           `tfsdk:"build_number"`
+	Status       types.String           `tfsdk:"status"`
+	Reason       types.String           `tfsdk:"reason"`
+	EnabledRules types.List             `tfsdk:"enabled_rules"`
+	HardFailures []PolicyViolationModel `tfsdk:"hard_failures"`
+	SoftFailures []PolicyViolationModel `tfsdk:"soft_failures"`
+	TimeTakenMS  types.Int64            `tfsdk:"time_taken_ms"`
+}
+
+type PolicyViolationModel struct {
+	Rule   types.String `tfsdk:"rule"`
+	Reason types.String `tfsdk:"reason"`
+}
+
+// CircleCI API models for policy decisions
+type PolicyDecisionAPI struct {
+	Status       string               `json:"status"`
+	Reason       string               `json:"reason,omitempty"`
+	EnabledRules []string             `json:"enabled_rules,omitempty"`
+	HardFailures []PolicyViolationAPI `json:"hard_failures,omitempty"`
+	SoftFailures []PolicyViolationAPI `json:"soft_failures,omitempty"`
+}
+
+type PolicyViolationAPI struct {
+	Rule   string `json:"rule"`
+	Reason string `json:"reason"`
+}
+
+type PolicyDecisionLogAPI struct {
+	ID        string            `json:"id"`
+	CreatedAt string            `json:"created_at"`
+	Decision  PolicyDecisionAPI `json:"decision"`
+	Metadata  struct {
+		ProjectID   string `json:"project_id"`
+		Branch      string `json:"branch"`
+		BuildNumber string `json:"build_number"`
+	} `json:"metadata"`
+	TimeTakenMS int64 `json:"time_taken_ms"`
+}
+
+func (d *PolicyDecisionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_decisions"
+}
+
+func (d *PolicyDecisionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Policy Decisions data source. Lists the policy decision log of an owner, newest first.",
+
+		Attributes: map[string]schema.Attribute{
+			"owner_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The organization ID that owns the policies.",
+			},
+			"context": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The policy context. Defaults to 'config'.",
+			},
+			"status": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return decisions with this status. One of 'PASS', 'SOFT_FAIL', 'HARD_FAIL', 'ERROR'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(policyDecisionStatuses...),
+				},
+			},
+			"branch": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return decisions for this branch.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return decisions for this project.",
+			},
+			"after": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return decisions made after this RFC 3339 timestamp.",
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"before": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return decisions made before this RFC 3339 timestamp.",
+				Validators: []validator.String{
+					rfc3339Validator{},
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The maximum number of decisions to return, newest first. Defaults to %d.", policyDecisionsDefaultLimit),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"decisions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of policy decisions.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the decision.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The date and time the decision was made.",
+						},
+						"project_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The project the decision was made for.",
+						},
+						"branch": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The branch the decision was made for.",
+						},
+						"build_number": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The build number the decision was made for.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The outcome of the decision.",
+						},
+						"reason": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The reason for an ERROR decision.",
+						},
+						"enabled_rules": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The rules that were evaluated.",
+						},
+						"hard_failures": policyViolationsSchema("The rules that failed and block the build."),
+						"soft_failures": policyViolationsSchema("The rules that failed without blocking the build."),
+						"time_taken_ms": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The time the decision took, in milliseconds.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PolicyDecisionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PolicyDecisionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PolicyDecisionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyContext := defaultPolicyContext
+	if !data.Context.IsNull() {
+		policyContext = data.Context.ValueString()
+	}
+
+	params := make(map[string]string)
+	for key, value := range map[string]types.String{
+		"status":     data.Status,
+		"branch":     data.Branch,
+		"project_id": data.ProjectID,
+		"after":      data.After,
+		"before":     data.Before,
+	} {
+		if !value.IsNull() && !value.IsUnknown() {
+			params[key] = value.ValueString()
+		}
+	}
+
+	limit := int64(policyDecisionsDefaultLimit)
+	if !data.Limit.IsNull() {
+		limit = data.Limit.ValueInt64()
+	}
+
+	endpoint := fmt.Sprintf("/owner/%s/context/%s/decision", data.OwnerID.ValueString(), policyContext)
+	logs, err := listPolicyDecisions(ctx, d.client, endpoint, params, int(limit))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list policy decisions, got error: %s", err))
+		return
+	}
+
+	data.Decisions = make([]PolicyDecisionLogDataModel, len(logs))
+	for i, log := range logs {
+		enabledRules, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(log.Decision.EnabledRules))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Decisions[i] = PolicyDecisionLogDataModel{
+			ID:           types.StringValue(log.ID),
+			CreatedAt:    types.StringValue(log.CreatedAt),
+			ProjectID:    types.StringValue(log.Metadata.ProjectID),
+			Branch:       types.StringValue(log.Metadata.Branch),
+			BuildNumber:  types.StringValue(log.Metadata.BuildNumber),
+			Status:       types.StringValue(log.Decision.Status),
+			Reason:       types.StringValue(log.Decision.Reason),
+			EnabledRules: enabledRules,
+			HardFailures: newPolicyViolationModels(log.Decision.HardFailures),
+			SoftFailures: newPolicyViolationModels(log.Decision.SoftFailures),
+			TimeTakenMS:  types.Int64Value(log.TimeTakenMS),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func policyViolationsSchema(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: description,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"rule": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The name of the rule.",
+				},
+				"reason": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "Why the rule failed.",
+				},
+			},
+		},
+	}
+}
+
+func newPolicyViolationModels(violations []PolicyViolationAPI) []PolicyViolationModel {
+	models := make([]PolicyViolationModel, len(violations))
+	for i, violation := range violations {
+		models[i] = PolicyViolationModel{
+			Rule:   types.StringValue(violation.Rule),
+			Reason: types.StringValue(violation.Reason),
+		}
+	}
+	return models
+}
+
+// nonNilStrings returns s, or an empty slice when s is nil, so that lists
+// built from it are empty rather than null.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+// listPolicyDecisions reads up to limit decision logs. The log is paged by
+// offset until an empty page comes back.
+func listPolicyDecisions(ctx context.Context, c *CircleCIClient, endpoint string, params map[string]string, limit int) ([]PolicyDecisionLogAPI, error) {
+	query := make(map[string]string, len(params)+1)
+	for key, value := range params {
+		query[key] = value
+	}
+
+	var logs []PolicyDecisionLogAPI
+	for len(logs) < limit {
+		query["offset"] = strconv.Itoa(len(logs))
+
+		var page []PolicyDecisionLogAPI
+		if err := c.Get(ctx, BuildURL(endpoint, query), &page); err != nil {
+			return nil, err
+		}
+
+		if len(page) == 0 {
+			break
+		}
+		logs = append(logs, page...)
+	}
+
+	if len(logs) > limit {
+		logs = logs[:limit]
+	}
+	return logs, nil
+}
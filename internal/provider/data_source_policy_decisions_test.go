@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestListPolicyDecisions(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		// An endless log: every offset returns a full page of two decisions.
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		fmt.Fprintf(w, `[{"id":"d%d"},{"id":"d%d"}]`, offset, offset+1)
+	}))
+	defer server.Close()
+
+	client := &CircleCIClient{BaseURL: server.URL, HTTPClient: server.Client()}
+
+	logs, err := listPolicyDecisions(context.Background(), client, "/owner/o/context/config/decision", map[string]string{"branch": "main"}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 3 || requests != 2 {
+		t.Errorf("got %d decisions after %d requests, want 3 after 2", len(logs), requests)
+	}
+	if logs[2].ID != "d2" {
+		t.Errorf("third decision = %q, want d2", logs[2].ID)
+	}
+}
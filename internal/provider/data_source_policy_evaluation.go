@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sigs.k8s.io/yaml"
+)
+
+var _ datasource.DataSource = &PolicyEvaluationDataSource{}
+
+func NewPolicyEvaluationDataSource() datasource.DataSource {
+	return &PolicyEvaluationDataSource{}
+}
+
+type PolicyEvaluationDataSource struct {
+	client *CircleCIClient
+}
+
+type PolicyEvaluationDataSourceModel struct {
+	OwnerID         types.String           `tfsdk:"owner_id"`
+	Context         types.String           `tfsdk:"context"`
+	Config          types.String           `tfsdk:"config"`
+	ProjectID       types.String           `tfsdk:"project_id"`
+	Branch          types.String           `tfsdk:"branch"`
+	Policies        types.Map              `tfsdk:"policies"`
+	FailOnViolation types.Bool             `tfsdk:"fail_on_violation"`
+	Status          types.String           `tfsdk:"status"`
+	Reason          types.String           `tfsdk:"reason"`
+	EnabledRules    types.List             `tfsdk:"enabled_rules"`
+	HardFailures    []PolicyViolationModel `tfsdk:"hard_failures"`
+	SoftFailures    []PolicyViolationModel `tfsdk:"soft_failures"`
+}
+
+// CircleCI API models for policy evaluation
+type PolicyEvaluationRequest struct {
+	Input    string                   `json:"input"`
+	Metadata PolicyEvaluationMetadata `json:"metadata,omitempty"`
+}
+
+type PolicyEvaluationMetadata struct {
+	ProjectID string `json:"project_id,omitempty"`
+	Branch    string `json:"branch,omitempty"`
+}
+
+func (d *PolicyEvaluationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_evaluation"
+}
+
+func (d *PolicyEvaluationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Policy Evaluation data source. Evaluates a `.circleci/config.yml` against the organization's config policies, " +
+			"either through the decision endpoint or locally against `policies`.",
+
+		Attributes: map[string]schema.Attribute{
+			"owner_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The organization ID whose policies to evaluate against. Either `owner_id` or `policies` must be specified.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("policies")),
+				},
+			},
+			"context": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The policy context. Defaults to 'config'.",
+			},
+			"config": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The CircleCI config to evaluate, as YAML.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The project ID passed to the policies as metadata.",
+			},
+			"branch": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The branch passed to the policies as metadata.",
+			},
+			"policies": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Map of policy file name to Rego content to evaluate locally instead of calling CircleCI. Files named `*_test.rego` are ignored.",
+			},
+			"fail_on_violation": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether a `HARD_FAIL` or `ERROR` decision fails the read. Defaults to false.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The outcome of the decision: 'PASS', 'SOFT_FAIL', 'HARD_FAIL' or 'ERROR'.",
+			},
+			"reason": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The reason for an ERROR decision.",
+			},
+			"enabled_rules": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The rules that were evaluated.",
+			},
+			"hard_failures": policyViolationsSchema("The rules that failed and would block the build."),
+			"soft_failures": policyViolationsSchema("The rules that failed without blocking the build."),
+		},
+	}
+}
+
+func (d *PolicyEvaluationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PolicyEvaluationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PolicyEvaluationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metadata := PolicyEvaluationMetadata{
+		ProjectID: data.ProjectID.ValueString(),
+		Branch:    data.Branch.ValueString(),
+	}
+
+	var decision *PolicyDecisionAPI
+	if !data.Policies.IsNull() {
+		var policies map[string]string
+		resp.Diagnostics.Append(data.Policies.ElementsAs(ctx, &policies, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var input any
+		if err := yaml.Unmarshal([]byte(data.Config.ValueString()), &input); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("config"), "Invalid Config", fmt.Sprintf("Unable to parse config YAML: %s", err))
+			return
+		}
+
+		meta := map[string]any{
+			"project_id": metadata.ProjectID,
+			"branch":     metadata.Branch,
+		}
+
+		var err error
+		decision, err = evaluatePolicies(ctx, policies, input, meta)
+		if err != nil {
+			decision = &PolicyDecisionAPI{Status: "ERROR", Reason: err.Error()}
+		}
+	} else {
+		policyContext := defaultPolicyContext
+		if !data.Context.IsNull() {
+			policyContext = data.Context.ValueString()
+		}
+
+		endpoint := fmt.Sprintf("/owner/%s/context/%s/decision", data.OwnerID.ValueString(), policyContext)
+		evaluation := PolicyEvaluationRequest{
+			Input:    data.Config.ValueString(),
+			Metadata: metadata,
+		}
+
+		decision = &PolicyDecisionAPI{}
+		if err := d.client.Post(ctx, endpoint, evaluation, decision); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to evaluate policies, got error: %s", err))
+			return
+		}
+	}
+
+	enabledRules, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(decision.EnabledRules))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Status = types.StringValue(decision.Status)
+	data.Reason = types.StringValue(decision.Reason)
+	data.EnabledRules = enabledRules
+	data.HardFailures = newPolicyViolationModels(decision.HardFailures)
+	data.SoftFailures = newPolicyViolationModels(decision.SoftFailures)
+
+	if data.FailOnViolation.ValueBool() && (decision.Status == "HARD_FAIL" || decision.Status == "ERROR") {
+		detail := fmt.Sprintf("The config was rejected with status %s.", decision.Status)
+		if decision.Reason != "" {
+			detail += "\n" + decision.Reason
+		}
+		for _, failure := range decision.HardFailures {
+			detail += fmt.Sprintf("\n- %s: %s", failure.Rule, failure.Reason)
+		}
+		resp.Diagnostics.AddAttributeError(path.Root("config"), "Policy Violation", detail)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
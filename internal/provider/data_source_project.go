@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -20,12 +23,49 @@ type ProjectDataSource struct {
 }
 
 type ProjectDataSourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	Slug         types.String `tfsdk:"slug"`
-	Name         types.String `tfsdk:"name"`
-	Organization types.String `tfsdk:"organization"`
-	VcsURL       types.String `tfsdk:"vcs_url"`
-	VcsType      types.String `tfsdk:"vcs_type"`
+	ID             types.String `tfsdk:"id"`
+	Slug           types.String `tfsdk:"slug"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	Name           types.String `tfsdk:"name"`
+	Organization   types.String `tfsdk:"organization"`
+	VcsURL         types.String `tfsdk:"vcs_url"`
+	VcsType        types.String `tfsdk:"vcs_type"`
+	VcsInfo        types.Object `tfsdk:"vcs_info"`
+}
+
+// resolveProjectSlug finds the slug of the project called name in the
+// organization orgID. VCS organizations build it from their own slug;
+// circleci/<org-id> organizations use project ids, so their projects are
+// listed instead.
+func resolveProjectSlug(ctx context.Context, c *CircleCIClient, orgID, name string) (string, error) {
+	collaborations, err := listCollaborations(ctx, c)
+	if err != nil {
+		return "", fmt.Errorf("unable to list collaborations: %w", err)
+	}
+
+	org := findCollaboration(collaborations, func(collaboration CollaborationAPI) bool {
+		return collaboration.ID == orgID
+	})
+	if org == nil {
+		return "", fmt.Errorf("organization %s is not among the collaborations of the API token", orgID)
+	}
+
+	if normalizeOrgSlug(org.Slug) != normalizeOrgSlug("circleci/"+orgID) {
+		return org.Slug + "/" + name, nil
+	}
+
+	projects, err := GetAllPagesOf[Project](ctx, c, fmt.Sprintf("/organization/%s/project", orgID), nil)
+	if err != nil {
+		return "", fmt.Errorf("unable to list organization projects: %w", err)
+	}
+
+	for _, project := range projects {
+		if project.Name == name {
+			return project.Slug, nil
+		}
+	}
+
+	return "", fmt.Errorf("project %q not found in organization %s", name, orgID)
 }
 
 func (d *ProjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -42,12 +82,27 @@ func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "The unique identifier of the project.",
 			},
 			"slug": schema.StringAttribute{
-				MarkdownDescription: "The project slug in the form 'vcs-slug/org-name/repo-name'.",
-				Required:            true,
+				MarkdownDescription: "The project slug in the form 'vcs-slug/org-name/repo-name', or 'circleci/org-id/project-id' for GitHub App and GitLab projects. Either `slug` or `organization_id` and `name` must be specified.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("organization_id")),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The unique identifier of the organization to look the project up in by `name`.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("name")),
+				},
 			},
 			"name": schema.StringAttribute{
+				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "The name of the project.",
+				MarkdownDescription: "The name of the project. Used with `organization_id` to look the project up; conflicts with `slug`.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("slug")),
+				},
 			},
 			"organization": schema.StringAttribute{
 				Computed:            true,
@@ -61,6 +116,24 @@ func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				Computed:            true,
 				MarkdownDescription: "The version control system type.",
 			},
+			"vcs_info": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Details of the repository the project builds.",
+				Attributes: map[string]schema.Attribute{
+					"vcs_url": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The URL of the repository.",
+					},
+					"provider": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The version control system provider.",
+					},
+					"default_branch": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The default branch of the repository.",
+					},
+				},
+			},
 		},
 	}
 }
@@ -90,8 +163,17 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	slug := EscapeProjectSlug(data.Slug.ValueString())
-	endpoint := fmt.Sprintf("/project/%s", slug)
+	slug := data.Slug.ValueString()
+	if data.Slug.IsNull() {
+		var err error
+		slug, err = resolveProjectSlug(ctx, d.client, data.OrganizationID.ValueString(), data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Project Not Found", fmt.Sprintf("Unable to resolve project, got error: %s", err))
+			return
+		}
+	}
+
+	endpoint := fmt.Sprintf("/project/%s", EscapeProjectSlug(slug))
 
 	var project Project
 	if err := d.client.Get(ctx, endpoint, &project); err != nil {
@@ -101,10 +183,18 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	// Update the model with the response
 	data.ID = types.StringValue(project.ID)
+	data.Slug = types.StringValue(slug)
 	data.Name = types.StringValue(project.Name)
 	data.Organization = types.StringValue(project.Organization)
 	data.VcsURL = types.StringValue(project.VcsInfo.VcsURL)
 	data.VcsType = types.StringValue(project.VcsInfo.Provider)
 
+	vcsInfo, diags := newVcsInfoObject(project.VcsInfo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.VcsInfo = vcsInfo
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
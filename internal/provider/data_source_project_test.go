@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestResolveProjectSlug(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/me/collaborations":
+			fmt.Fprint(w, `[{"id":"org-vcs","slug":"gh/acme","vcs-type":"github"},{"id":"org-app","slug":"circleci/org-app","vcs-type":"circleci"}]`)
+		case "/organization/org-app/project":
+			fmt.Fprint(w, `{"items":[{"id":"p1","name":"api","slug":"circleci/org-app/p1"}]}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := &CircleCIClient{BaseURL: server.URL, HTTPClient: server.Client()}
+
+	tests := []struct {
+		orgID   string
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{orgID: "org-vcs", name: "api", want: "gh/acme/api"},
+		{orgID: "org-app", name: "api", want: "circleci/org-app/p1"},
+		{orgID: "org-app", name: "web", wantErr: true},
+		{orgID: "org-unknown", name: "api", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := resolveProjectSlug(context.Background(), client, tt.orgID, tt.name)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("resolveProjectSlug(%q, %q) error = %v, wantErr %v", tt.orgID, tt.name, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("resolveProjectSlug(%q, %q) = %q, want %q", tt.orgID, tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &RunnerResourceClassesDataSource{}
+
+func NewRunnerResourceClassesDataSource() datasource.DataSource {
+	return &RunnerResourceClassesDataSource{}
+}
+
+type RunnerResourceClassesDataSource struct {
+	client *CircleCIClient
+}
+
+type RunnerResourceClassesDataSourceModel struct {
+	Namespace       types.String                   `tfsdk:"namespace"`
+	ResourceClasses []RunnerResourceClassDataModel `tfsdk:"resource_classes"`
+}
+
+type RunnerResourceClassDataModel struct {
+	ID            types.String `tfsdk:"id"`
+	ResourceClass types.String `tfsdk:"resource_class"`
+	Description   types.String `tfsdk:"description"`
+}
+
+// CircleCI API models for runner resource classes
+type RunnerResourceClassAPI struct {
+	ID            string `json:"id"`
+	ResourceClass string `json:"resource_class"`
+	Description   string `json:"description"`
+}
+
+func (d *RunnerResourceClassesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_runner_resource_classes"
+}
+
+func (d *RunnerResourceClassesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Runner Resource Classes data source. Lists the self-hosted runner resource classes in a namespace.",
+
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The namespace (usually the organization name) to list resource classes for.",
+			},
+			"resource_classes": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of resource classes in the namespace.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the resource class.",
+						},
+						"resource_class": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The resource class name in the form 'namespace/name'.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The description of the resource class.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RunnerResourceClassesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RunnerResourceClassesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RunnerResourceClassesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceClasses, err := listRunnerResourceClasses(ctx, d.client, data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list runner resource classes, got error: %s", err))
+		return
+	}
+
+	data.ResourceClasses = make([]RunnerResourceClassDataModel, len(resourceClasses))
+	for i, rc := range resourceClasses {
+		data.ResourceClasses[i] = RunnerResourceClassDataModel{
+			ID:            types.StringValue(rc.ID),
+			ResourceClass: types.StringValue(rc.ResourceClass),
+			Description:   types.StringValue(rc.Description),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listRunnerResourceClasses returns the self-hosted runner resource classes of
+// a namespace.
+func listRunnerResourceClasses(ctx context.Context, c *CircleCIClient, namespace string) ([]RunnerResourceClassAPI, error) {
+	var resourceClasses struct {
+		Items []RunnerResourceClassAPI `json:"items"`
+	}
+	url := BuildURL("/runner/resource", map[string]string{
+		"namespace": namespace,
+	})
+	if err := c.Get(ctx, url, &resourceClasses); err != nil {
+		return nil, err
+	}
+	return resourceClasses.Items, nil
+}
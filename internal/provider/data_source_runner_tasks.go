@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &RunnerTasksDataSource{}
+
+func NewRunnerTasksDataSource() datasource.DataSource {
+	return &RunnerTasksDataSource{}
+}
+
+type RunnerTasksDataSource struct {
+	client *CircleCIClient
+}
+
+type RunnerTasksDataSourceModel struct {
+	ResourceClass      types.String `tfsdk:"resource_class"`
+	UnclaimedTaskCount types.Int64  `tfsdk:"unclaimed_task_count"`
+	RunningTaskCount   types.Int64  `tfsdk:"running_task_count"`
+}
+
+func (d *RunnerTasksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_runner_tasks"
+}
+
+func (d *RunnerTasksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Runner Tasks data source. Reports the number of unclaimed and running tasks for a runner resource class, for example to drive autoscaling.",
+
+		Attributes: map[string]schema.Attribute{
+			"resource_class": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The resource class to count tasks for.",
+			},
+			"unclaimed_task_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of tasks waiting to be claimed by a runner.",
+			},
+			"running_task_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of tasks currently running on runners.",
+			},
+		},
+	}
+}
+
+func (d *RunnerTasksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RunnerTasksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RunnerTasksDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := map[string]string{
+		"resource-class": data.ResourceClass.ValueString(),
+	}
+
+	var unclaimed struct {
+		UnclaimedTaskCount int64 `json:"unclaimed_task_count"`
+	}
+	if err := d.client.Get(ctx, BuildURL("/runner/tasks", params), &unclaimed); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read unclaimed runner tasks, got error: %s", err))
+		return
+	}
+
+	var running struct {
+		RunningRunnerTasks int64 `json:"running_runner_tasks"`
+	}
+	if err := d.client.Get(ctx, BuildURL("/runner/tasks/running", params), &running); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read running runner tasks, got error: %s", err))
+		return
+	}
+
+	data.UnclaimedTaskCount = types.Int64Value(unclaimed.UnclaimedTaskCount)
+	data.RunningTaskCount = types.Int64Value(running.RunningRunnerTasks)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &RunnersDataSource{}
+
+func NewRunnersDataSource() datasource.DataSource {
+	return &RunnersDataSource{}
+}
+
+type RunnersDataSource struct {
+	client *CircleCIClient
+}
+
+type RunnersDataSourceModel struct {
+	ResourceClass types.String          `tfsdk:"resource_class"`
+	Namespace     types.String          `tfsdk:"namespace"`
+	Runners       []RunnerResourceModel `tfsdk:"runners"`
+}
+
+func (d *RunnersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_runners"
+}
+
+func (d *RunnersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Runners data source. Lists the self-hosted runners connected for a resource class or namespace.",
+
+		Attributes: map[string]schema.Attribute{
+			"resource_class": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The resource class to list runners for. Either 'resource_class' or 'namespace' must be specified.",
+			},
+			"namespace": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The namespace to list runners for. Either 'resource_class' or 'namespace' must be specified.",
+			},
+			"runners": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of runners.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the runner.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the runner.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "A description of the runner.",
+						},
+						"resource_class": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The resource class of the runner.",
+						},
+						"platform": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The platform of the runner (linux, windows, darwin).",
+						},
+						"ip": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The IP address of the runner.",
+						},
+						"hostname": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The hostname of the runner.",
+						},
+						"version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The version of the runner agent.",
+						},
+						"first_connected": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The date and time the runner first connected.",
+						},
+						"last_connected": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The date and time the runner last connected.",
+						},
+						"last_used": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The date and time the runner was last used.",
+						},
+						"state": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The current state of the runner.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RunnersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RunnersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RunnersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := make(map[string]string)
+
+	if !data.ResourceClass.IsNull() && !data.ResourceClass.IsUnknown() {
+		params["resource-class"] = data.ResourceClass.ValueString()
+	}
+
+	if !data.Namespace.IsNull() && !data.Namespace.IsUnknown() {
+		params["namespace"] = data.Namespace.ValueString()
+	}
+
+	if len(params) == 0 {
+		resp.Diagnostics.AddError("Missing Required Attribute", "Either 'resource_class' or 'namespace' must be specified")
+		return
+	}
+
+	var runners struct {
+		Items []RunnerAPI `json:"items"`
+	}
+	if err := d.client.Get(ctx, BuildURL("/runner", params), &runners); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list runners, got error: %s", err))
+		return
+	}
+
+	data.Runners = make([]RunnerResourceModel, len(runners.Items))
+	for i := range runners.Items {
+		mapRunnerToModel(&runners.Items[i], &data.Runners[i])
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
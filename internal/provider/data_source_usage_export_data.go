@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &UsageExportDataDataSource{}
+
+func NewUsageExportDataDataSource() datasource.DataSource {
+	return &UsageExportDataDataSource{}
+}
+
+type UsageExportDataDataSource struct {
+	client *CircleCIClient
+}
+
+type UsageExportDataDataSourceModel struct {
+	OrgID        types.String          `tfsdk:"org_id"`
+	ExportID     types.String          `tfsdk:"export_id"`
+	GroupBy      types.String          `tfsdk:"group_by"`
+	IncludeRows  types.Bool            `tfsdk:"include_rows"`
+	TotalCredits types.Float64         `tfsdk:"total_credits"`
+	Groups       []UsageGroupDataModel `tfsdk:"groups"`
+	Rows         []UsageRowDataModel   `tfsdk:"rows"`
+}
+
+type UsageGroupDataModel struct {
+	Key            types.String  `tfsdk:"key"`
+	JobRuns        types.Int64   `tfsdk:"job_runs"`
+	Minutes        types.Float64 `tfsdk:"minutes"`
+	ComputeCredits types.Float64 `tfsdk:"compute_credits"`
+	DLCCredits     types.Float64 `tfsdk:"dlc_credits"`
+	NetworkCredits types.Float64 `tfsdk:"network_credits"`
+	StorageCredits types.Float64 `tfsdk:"storage_credits"`
+	TotalCredits   types.Float64 `tfsdk:"total_credits"`
+}
+
+type UsageRowDataModel struct {
+	ProjectName    types.String  `tfsdk:"project_name"`
+	WorkflowName   types.String  `tfsdk:"workflow_name"`
+	JobName        types.String  `tfsdk:"job_name"`
+	ResourceClass  types.String  `tfsdk:"resource_class"`
+	JobRunDate     types.String  `tfsdk:"job_run_date"`
+	JobRunSeconds  types.Float64 `tfsdk:"job_run_seconds"`
+	ComputeCredits types.Float64 `tfsdk:"compute_credits"`
+	DLCCredits     types.Float64 `tfsdk:"dlc_credits"`
+	NetworkCredits types.Float64 `tfsdk:"network_credits"`
+	StorageCredits types.Float64 `tfsdk:"storage_credits"`
+	TotalCredits   types.Float64 `tfsdk:"total_credits"`
+}
+
+func (d *UsageExportDataDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage_export_data"
+}
+
+func (d *UsageExportDataDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	creditAttributes := map[string]schema.Attribute{
+		"compute_credits": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Compute credits used.",
+		},
+		"dlc_credits": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Docker layer caching credits used.",
+		},
+		"network_credits": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Network egress credits used.",
+		},
+		"storage_credits": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Storage credits used.",
+		},
+		"total_credits": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Total credits used.",
+		},
+	}
+
+	groupAttributes := map[string]schema.Attribute{
+		"key": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The grouping key: the project name, 'project/workflow', 'project/workflow/job', the resource class or the day (YYYY-MM-DD).",
+		},
+		"job_runs": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The number of job runs.",
+		},
+		"minutes": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The total job run time in minutes.",
+		},
+	}
+	rowAttributes := map[string]schema.Attribute{
+		"project_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the project.",
+		},
+		"workflow_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the workflow.",
+		},
+		"job_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the job.",
+		},
+		"resource_class": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The resource class the job ran on.",
+		},
+		"job_run_date": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The date the job ran.",
+		},
+		"job_run_seconds": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The job run time in seconds.",
+		},
+	}
+	for name, attribute := range creditAttributes {
+		groupAttributes[name] = attribute
+		rowAttributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Usage Export Data data source. Downloads the CSV files of a completed usage export and aggregates them.",
+
+		Attributes: map[string]schema.Attribute{
+			"org_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The organization ID the export belongs to.",
+			},
+			"export_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of a completed usage export.",
+			},
+			"group_by": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The dimension to aggregate on. One of 'project', 'workflow', 'job', 'resource_class', 'day'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(usageExportGroupings...),
+				},
+			},
+			"include_rows": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to also return every job run in `rows`. Defaults to false; exports can hold many rows.",
+			},
+			"total_credits": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The total credits used across the export.",
+			},
+			"groups": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Usage aggregated by `group_by`, sorted by descending total credits.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: groupAttributes,
+				},
+			},
+			"rows": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Every job run of the export. Only set when `include_rows` is true.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: rowAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *UsageExportDataDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsageExportDataDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsageExportDataDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	export, err := getUsageExport(ctx, d.client, data.OrgID.ValueString(), data.ExportID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read usage export, got error: %s", err))
+		return
+	}
+
+	if export.Status != "completed" {
+		resp.Diagnostics.AddError(
+			"Usage Export Not Completed",
+			fmt.Sprintf("Usage export %s has status %q; only completed exports can be read.", export.ID, export.Status),
+		)
+		return
+	}
+
+	var rows []UsageRow
+	for _, downloadURL := range export.DownloadURLs {
+		fileRows, err := d.downloadUsageFile(ctx, downloadURL)
+		if err != nil {
+			resp.Diagnostics.AddError("Download Error", fmt.Sprintf("Unable to read usage export file, got error: %s", err))
+			return
+		}
+		rows = append(rows, fileRows...)
+	}
+
+	groups, err := groupUsageRows(rows, data.GroupBy.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Grouping", err.Error())
+		return
+	}
+
+	var total float64
+	data.Groups = make([]UsageGroupDataModel, len(groups))
+	for i, group := range groups {
+		total += group.TotalCredits
+		data.Groups[i] = UsageGroupDataModel{
+			Key:            types.StringValue(group.Key),
+			JobRuns:        types.Int64Value(group.JobRuns),
+			Minutes:        types.Float64Value(group.Minutes),
+			ComputeCredits: types.Float64Value(group.ComputeCredits),
+			DLCCredits:     types.Float64Value(group.DLCCredits),
+			NetworkCredits: types.Float64Value(group.NetworkCredits),
+			StorageCredits: types.Float64Value(group.StorageCredits),
+			TotalCredits:   types.Float64Value(group.TotalCredits),
+		}
+	}
+	data.TotalCredits = types.Float64Value(total)
+
+	data.Rows = nil
+	if data.IncludeRows.ValueBool() {
+		data.Rows = make([]UsageRowDataModel, len(rows))
+		for i, row := range rows {
+			data.Rows[i] = UsageRowDataModel{
+				ProjectName:    types.StringValue(row.ProjectName),
+				WorkflowName:   types.StringValue(row.WorkflowName),
+				JobName:        types.StringValue(row.JobName),
+				ResourceClass:  types.StringValue(row.ResourceClass),
+				JobRunDate:     types.StringValue(row.JobRunDate),
+				JobRunSeconds:  types.Float64Value(row.JobRunSeconds),
+				ComputeCredits: types.Float64Value(row.ComputeCredits),
+				DLCCredits:     types.Float64Value(row.DLCCredits),
+				NetworkCredits: types.Float64Value(row.NetworkCredits),
+				StorageCredits: types.Float64Value(row.StorageCredits),
+				TotalCredits:   types.Float64Value(row.TotalCredits),
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// getUsageExport reads a usage export of an organization.
+func getUsageExport(ctx context.Context, c *CircleCIClient, orgID, exportID string) (UsageExportAPI, error) {
+	var export UsageExportAPI
+	err := c.Get(ctx, fmt.Sprintf("/organization/%s/usage-export/%s", orgID, exportID), &export)
+	return export, err
+}
+
+// downloadUsageFile fetches and parses one export file. The URLs are
+// pre-signed, so the CircleCI token is not sent.
+func (d *UsageExportDataDataSource) downloadUsageFile(ctx context.Context, downloadURL string) ([]UsageRow, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := d.client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP %d downloading usage export file", resp.StatusCode)
+	}
+
+	return parseUsageExportCSV(resp.Body)
+}
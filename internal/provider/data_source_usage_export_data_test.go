@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetUsageExport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/organization/org-1/usage-export/exp-1" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"id":"exp-1","org_id":"org-1","status":"completed","download_urls":["https://example.com/usage.csv.gz"]}`)
+	}))
+	defer server.Close()
+
+	// Same shape as the default base URL, so a doubled version prefix 404s.
+	client := &CircleCIClient{BaseURL: server.URL + "/api/v2", HTTPClient: server.Client()}
+
+	export, err := getUsageExport(context.Background(), client, "org-1", "exp-1")
+	if err != nil {
+		t.Fatalf("getUsageExport() error = %v", err)
+	}
+	if export.ID != "exp-1" || export.Status != "completed" || len(export.DownloadURLs) != 1 {
+		t.Errorf("getUsageExport() = %+v, want completed export exp-1 with one download URL", export)
+	}
+}
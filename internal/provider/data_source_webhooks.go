@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &WebhooksDataSource{}
+
+func NewWebhooksDataSource() datasource.DataSource {
+	return &WebhooksDataSource{}
+}
+
+type WebhooksDataSource struct {
+	client *CircleCIClient
+}
+
+type WebhooksDataSourceModel struct {
+	ScopeID     types.String           `tfsdk:"scope_id"`
+	ScopeType   types.String           `tfsdk:"scope_type"`
+	ProjectSlug types.String           `tfsdk:"project_slug"`
+	URLPattern  types.String           `tfsdk:"url_pattern"`
+	Webhooks    []WebhookDataItemModel `tfsdk:"webhooks"`
+}
+
+type WebhookDataItemModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	URL       types.String `tfsdk:"url"`
+	Events    types.List   `tfsdk:"events"`
+	VerifyTLS types.Bool   `tfsdk:"verify_tls"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func (d *WebhooksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhooks"
+}
+
+func (d *WebhooksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Webhooks data source. Lists the webhooks configured for a scope.",
+
+		Attributes: map[string]schema.Attribute{
+			"scope_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The unique identifier of the scope. Either `scope_id` or `project_slug` must be specified.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("project_slug")),
+				},
+			},
+			"scope_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The type of the scope. Defaults to 'project'.",
+			},
+			"project_slug": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The slug of the project whose webhooks to list, in the form 'vcs-slug/org-name/repo-name'.",
+			},
+			"url_pattern": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A regular expression. Only webhooks whose URL matches are returned.",
+			},
+			"webhooks": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of webhooks.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the webhook.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the webhook.",
+						},
+						"url": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The URL to which webhooks are sent.",
+						},
+						"events": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The events that trigger the webhook.",
+						},
+						"verify_tls": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether TLS certificates are verified when sending webhooks.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The date and time when the webhook was created.",
+						},
+						"updated_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The date and time when the webhook was last updated.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WebhooksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WebhooksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WebhooksDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var pattern *regexp.Regexp
+	if !data.URLPattern.IsNull() {
+		var err error
+		pattern, err = regexp.Compile(data.URLPattern.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("url_pattern"), "Invalid URL Pattern", err.Error())
+			return
+		}
+	}
+
+	scopeID := data.ScopeID.ValueString()
+	if !data.ProjectSlug.IsNull() {
+		projectID, err := lookupProjectID(ctx, d.client, data.ProjectSlug.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve project %q, got error: %s", data.ProjectSlug.ValueString(), err))
+			return
+		}
+		scopeID = projectID
+	}
+
+	scopeType := "project"
+	if !data.ScopeType.IsNull() {
+		scopeType = data.ScopeType.ValueString()
+	}
+
+	webhooks, err := GetAllPagesOf[Webhook](ctx, d.client, "/webhook", map[string]string{
+		"scope-id":   scopeID,
+		"scope-type": scopeType,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list webhooks, got error: %s", err))
+		return
+	}
+
+	data.Webhooks = []WebhookDataItemModel{}
+	for _, webhook := range webhooks {
+		if pattern != nil && !pattern.MatchString(webhook.URL) {
+			continue
+		}
+
+		events, diags := types.ListValueFrom(ctx, types.StringType, webhook.Events)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Webhooks = append(data.Webhooks, WebhookDataItemModel{
+			ID:        types.StringValue(webhook.ID),
+			Name:      types.StringValue(webhook.Name),
+			URL:       types.StringValue(webhook.URL),
+			Events:    events,
+			VerifyTLS: types.BoolValue(webhook.VerifyTLS),
+			CreatedAt: types.StringValue(webhook.CreatedAt),
+			UpdatedAt: types.StringValue(webhook.UpdatedAt),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
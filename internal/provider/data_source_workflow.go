@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &WorkflowDataSource{}
+
+func NewWorkflowDataSource() datasource.DataSource {
+	return &WorkflowDataSource{}
+}
+
+type WorkflowDataSource struct {
+	client *CircleCIClient
+}
+
+type WorkflowDataSourceModel struct {
+	ID             types.String           `tfsdk:"id"`
+	Name           types.String           `tfsdk:"name"`
+	Status         types.String           `tfsdk:"status"`
+	PipelineID     types.String           `tfsdk:"pipeline_id"`
+	PipelineNumber types.Int64            `tfsdk:"pipeline_number"`
+	ProjectSlug    types.String           `tfsdk:"project_slug"`
+	CreatedAt      types.String           `tfsdk:"created_at"`
+	StoppedAt      types.String           `tfsdk:"stopped_at"`
+	Jobs           []WorkflowJobDataModel `tfsdk:"jobs"`
+}
+
+type WorkflowJobDataModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Type              types.String `tfsdk:"type"`
+	Status            types.String `tfsdk:"status"`
+	JobNumber         types.Int64  `tfsdk:"job_number"`
+	ApprovalRequestID types.String `tfsdk:"approval_request_id"`
+	Dependencies      types.List   `tfsdk:"dependencies"`
+	StartedAt         types.String `tfsdk:"started_at"`
+	StoppedAt         types.String `tfsdk:"stopped_at"`
+}
+
+// CircleCI API models for the jobs of a workflow
+type WorkflowJobAPI struct {
+	ID                string   `json:"id"`
+	Name              string   `json:"name"`
+	Type              string   `json:"type"`
+	Status            string   `json:"status"`
+	JobNumber         *int64   `json:"job_number,omitempty"`
+	ApprovalRequestID string   `json:"approval_request_id,omitempty"`
+	Dependencies      []string `json:"dependencies"`
+	ProjectSlug       string   `json:"project_slug"`
+	StartedAt         string   `json:"started_at"`
+	StoppedAt         string   `json:"stopped_at"`
+}
+
+// listWorkflowJobs returns every job of a workflow.
+func listWorkflowJobs(ctx context.Context, c *CircleCIClient, workflowID string) ([]WorkflowJobAPI, error) {
+	return GetAllPagesOf[WorkflowJobAPI](ctx, c, fmt.Sprintf("/workflow/%s/job", workflowID), nil)
+}
+
+func (d *WorkflowDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow"
+}
+
+func (d *WorkflowDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Workflow data source. Looks up a workflow and the status of its jobs.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The unique identifier of the workflow.",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the workflow.",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the workflow (success, running, not_run, failed, error, failing, on_hold, canceled, unauthorized).",
+			},
+			"pipeline_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the pipeline the workflow belongs to.",
+			},
+			"pipeline_number": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of the pipeline the workflow belongs to.",
+			},
+			"project_slug": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The project slug in the form 'vcs-slug/org-name/repo-name'.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date and time the workflow was created.",
+			},
+			"stopped_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date and time the workflow stopped.",
+			},
+			"jobs": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The jobs of the workflow.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the job.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the job.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the job, 'build' or 'approval'.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of the job.",
+						},
+						"job_number": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of the job. Null for approval jobs and jobs that have not started.",
+						},
+						"approval_request_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID to approve the job with. Only set for approval jobs.",
+						},
+						"dependencies": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The IDs of the jobs this job depends on.",
+						},
+						"started_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The date and time the job started.",
+						},
+						"stopped_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The date and time the job stopped.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WorkflowDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WorkflowDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WorkflowDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var workflow WorkflowAPI
+	if err := d.client.Get(ctx, fmt.Sprintf("/workflow/%s", data.ID.ValueString()), &workflow); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow, got error: %s", err))
+		return
+	}
+
+	jobs, err := listWorkflowJobs(ctx, d.client, workflow.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list workflow jobs, got error: %s", err))
+		return
+	}
+
+	data.Name = types.StringValue(workflow.Name)
+	data.Status = types.StringValue(workflow.Status)
+	data.PipelineID = types.StringValue(workflow.PipelineID)
+	data.PipelineNumber = types.Int64Value(workflow.PipelineNumber)
+	data.ProjectSlug = types.StringValue(workflow.ProjectSlug)
+	data.CreatedAt = types.StringValue(workflow.CreatedAt)
+	data.StoppedAt = types.StringValue(workflow.StoppedAt)
+
+	data.Jobs = make([]WorkflowJobDataModel, len(jobs))
+	for i, job := range jobs {
+		dependencies, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(job.Dependencies))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Jobs[i] = WorkflowJobDataModel{
+			ID:                types.StringValue(job.ID),
+			Name:              types.StringValue(job.Name),
+			Type:              types.StringValue(job.Type),
+			Status:            types.StringValue(job.Status),
+			JobNumber:         types.Int64PointerValue(job.JobNumber),
+			ApprovalRequestID: types.StringValue(job.ApprovalRequestID),
+			Dependencies:      dependencies,
+			StartedAt:         types.StringValue(job.StartedAt),
+			StoppedAt:         types.StringValue(job.StoppedAt),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
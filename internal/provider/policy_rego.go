@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/open-policy-agent/opa/v1/rego"
+	"github.com/open-policy-agent/opa/v1/storage/inmem"
+	"github.com/open-policy-agent/opa/v1/tester"
+)
+
+// CircleCI config policies are written in Rego v0 syntax, with the helper
+// library available under data.circleci.
+const policyRegoVersion = ast.RegoV0
+
+const circleCIHelpersPrefix = "undefined function data.circleci."
+
+// regoFile is a Rego source checked locally before it is sent to CircleCI.
+type regoFile struct {
+	Name    string
+	Content string
+	// Path is the attribute diagnostics for this file are reported on.
+	Path path.Path
+	// Test marks *_test.rego files, which are exempt from the policy
+	// conventions and executed rather than uploaded.
+	Test bool
+}
+
+// isRegoTestFile reports whether name follows the *_test.rego convention.
+func isRegoTestFile(name string) bool {
+	return strings.HasSuffix(name, "_test.rego")
+}
+
+// validateRegoFiles parses and compiles files, checks that every policy
+// declares `package org` and a `policy_name`, and runs the test files.
+// Problems are reported as attribute errors on each file's path.
+func validateRegoFiles(ctx context.Context, files []regoFile) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	modules := make(map[string]*ast.Module, len(files))
+	paths := make(map[string]path.Path, len(files))
+	hasTests := false
+
+	for _, file := range files {
+		paths[file.Name] = file.Path
+
+		module, err := ast.ParseModuleWithOpts(file.Name, file.Content, ast.ParserOptions{RegoVersion: policyRegoVersion})
+		if err != nil {
+			diags.AddAttributeError(file.Path, "Invalid Rego", err.Error())
+			continue
+		}
+		if module == nil {
+			diags.AddAttributeError(file.Path, "Invalid Rego", fmt.Sprintf("%s: empty module", file.Name))
+			continue
+		}
+		modules[file.Name] = module
+
+		if file.Test {
+			hasTests = true
+			continue
+		}
+
+		if !module.Package.Path.Equal(ast.MustParseRef("data.org")) {
+			diags.AddAttributeError(file.Path, "Invalid Policy Package",
+				fmt.Sprintf("%s: policies must declare `package org`, got `package %s`.", file.Name, strings.TrimPrefix(module.Package.Path.String(), "data.")))
+		}
+		if !hasRule(module, "policy_name") {
+			diags.AddAttributeError(file.Path, "Missing Policy Name",
+				fmt.Sprintf("%s: policies must define a `policy_name` rule, for example `policy_name := \"my_policy\"`.", file.Name))
+		}
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	// The CircleCI helper functions are only known to the policy service, so
+	// calls to them are not reported. Tests cannot run without them though.
+	usesHelpers := false
+	compiler := ast.NewCompiler().WithDefaultRegoVersion(policyRegoVersion)
+	compiler.Compile(modules)
+	for _, err := range compiler.Errors {
+		if strings.HasPrefix(err.Message, circleCIHelpersPrefix) {
+			usesHelpers = true
+			continue
+		}
+		diags.AddAttributeError(regoErrorPath(err, paths, files), "Invalid Rego", err.Error())
+	}
+
+	if diags.HasError() || !hasTests {
+		return diags
+	}
+
+	if usesHelpers {
+		for _, file := range files {
+			if file.Test {
+				diags.AddAttributeWarning(file.Path, "Policy Tests Skipped",
+					fmt.Sprintf("%s: the policies call data.circleci helpers, which are not available locally.", file.Name))
+			}
+		}
+		return diags
+	}
+
+	results, err := tester.NewRunner().SetDefaultRegoVersion(policyRegoVersion).Run(ctx, modules)
+	if err != nil {
+		for _, file := range files {
+			if file.Test {
+				diags.AddAttributeError(file.Path, "Policy Tests Failed", err.Error())
+			}
+		}
+		return diags
+	}
+
+	var failures []*tester.Result
+	for result := range results {
+		if result.Fail || result.Error != nil {
+			failures = append(failures, result)
+		}
+	}
+
+	// Results arrive in parallel; sort them so the diagnostics are stable.
+	sort.Slice(failures, func(i, j int) bool {
+		return failures[i].Location.String() < failures[j].Location.String()
+	})
+	for _, result := range failures {
+		detail := fmt.Sprintf("%s: %s.%s failed", result.Location, result.Package, result.Name)
+		if result.Error != nil {
+			detail = fmt.Sprintf("%s: %s.%s errored: %s", result.Location, result.Package, result.Name, result.Error)
+		}
+		diags.AddAttributeError(paths[result.Location.File], "Policy Test Failed", detail)
+	}
+
+	return diags
+}
+
+func hasRule(module *ast.Module, name string) bool {
+	for _, rule := range module.Rules {
+		if rule.Head.Name.String() == name || rule.Head.Ref().String() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// regoErrorPath returns the attribute path of the file an error points at,
+// falling back to the first file when the error has no location.
+func regoErrorPath(err *ast.Error, paths map[string]path.Path, files []regoFile) path.Path {
+	if err.Location != nil {
+		if p, ok := paths[err.Location.File]; ok {
+			return p
+		}
+	}
+	return files[0].Path
+}
+
+// evaluatePolicies evaluates a parsed CircleCI config against policies the
+// way the policy service does: every rule named in enable_rule is collected,
+// rules also named in hard_fail are hard failures, the rest soft failures.
+// The decision metadata is available to policies as data.meta.
+func evaluatePolicies(ctx context.Context, policies map[string]string, input any, meta map[string]any) (*PolicyDecisionAPI, error) {
+	options := []func(*rego.Rego){
+		rego.Query("data.org"),
+		rego.Input(input),
+		rego.Store(inmem.NewFromObject(map[string]any{"meta": meta})),
+		rego.SetRegoVersion(policyRegoVersion),
+	}
+
+	names := make([]string, 0, len(policies))
+	for name := range policies {
+		if !isRegoTestFile(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		options = append(options, rego.Module(name, policies[name]))
+	}
+
+	results, err := rego.New(options...).Eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	org := map[string]any{}
+	if len(results) > 0 && len(results[0].Expressions) > 0 {
+		if value, ok := results[0].Expressions[0].Value.(map[string]any); ok {
+			org = value
+		}
+	}
+
+	decision := &PolicyDecisionAPI{
+		Status:       "PASS",
+		EnabledRules: regoStrings(org["enable_rule"]),
+		HardFailures: []PolicyViolationAPI{},
+		SoftFailures: []PolicyViolationAPI{},
+	}
+	sort.Strings(decision.EnabledRules)
+
+	hard := map[string]bool{}
+	for _, rule := range regoStrings(org["hard_fail"]) {
+		hard[rule] = true
+	}
+
+	for _, rule := range decision.EnabledRules {
+		for _, reason := range regoStrings(org[rule]) {
+			violation := PolicyViolationAPI{Rule: rule, Reason: reason}
+			if hard[rule] {
+				decision.HardFailures = append(decision.HardFailures, violation)
+			} else {
+				decision.SoftFailures = append(decision.SoftFailures, violation)
+			}
+		}
+	}
+
+	switch {
+	case len(decision.HardFailures) > 0:
+		decision.Status = "HARD_FAIL"
+	case len(decision.SoftFailures) > 0:
+		decision.Status = "SOFT_FAIL"
+	}
+
+	return decision, nil
+}
+
+// regoStrings flattens a rule value into its string members. Sets and arrays
+// yield their strings, objects their string values, and a string itself.
+func regoStrings(value any) []string {
+	var out []string
+	switch v := value.(type) {
+	case string:
+		out = append(out, v)
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+	case map[string]any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+		sort.Strings(out)
+	}
+	return out
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+const testPolicy = `package org
+
+import future.keywords
+
+policy_name["ban_large_resources"]
+
+enable_rule["ban_large"]
+
+ban_large[reason] {
+	some name, job in input.jobs
+	job.resource_class == "xlarge"
+	reason := sprintf("job %s uses a banned resource class", [name])
+}
+`
+
+const testPolicyHelpers = `package org
+
+import future.keywords
+import data.circleci.config
+
+policy_name["require_orbs"]
+
+enable_rule["require_security_orb"]
+
+require_security_orb = config.require_orbs(["circleci/security"])
+`
+
+func TestValidateRegoFiles(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		wantErr  string
+		wantWarn string
+	}{
+		{
+			name:  "valid policy",
+			files: map[string]string{"policy.rego": testPolicy},
+		},
+		{
+			name:    "syntax error",
+			files:   map[string]string{"policy.rego": "package org\n\npolicy_name[\"x\"\n"},
+			wantErr: "Invalid Rego",
+		},
+		{
+			name:    "wrong package",
+			files:   map[string]string{"policy.rego": strings.Replace(testPolicy, "package org", "package other", 1)},
+			wantErr: "Invalid Policy Package",
+		},
+		{
+			name:    "missing policy name",
+			files:   map[string]string{"policy.rego": strings.Replace(testPolicy, `policy_name["ban_large_resources"]`, "", 1)},
+			wantErr: "Missing Policy Name",
+		},
+		{
+			name:    "compile error",
+			files:   map[string]string{"policy.rego": testPolicy + "\nbroken { undefined_function(1) }\n"},
+			wantErr: "Invalid Rego",
+		},
+		{
+			name:  "circleci helpers are ignored",
+			files: map[string]string{"policy.rego": testPolicyHelpers},
+		},
+		{
+			name: "passing test",
+			files: map[string]string{
+				"policy.rego": testPolicy,
+				"policy_test.rego": `package org
+
+test_ban_large {
+	count(ban_large) == 1 with input as {"jobs": {"build": {"resource_class": "xlarge"}}}
+}
+`,
+			},
+		},
+		{
+			name: "failing test",
+			files: map[string]string{
+				"policy.rego": testPolicy,
+				"policy_test.rego": `package org
+
+test_ban_large {
+	count(ban_large) == 0 with input as {"jobs": {"build": {"resource_class": "xlarge"}}}
+}
+`,
+			},
+			wantErr: "Policy Test Failed",
+		},
+		{
+			name: "tests skipped with helpers",
+			files: map[string]string{
+				"policy.rego":      testPolicyHelpers,
+				"policy_test.rego": "package org\n\ntest_anything { true }\n",
+			},
+			wantWarn: "Policy Tests Skipped",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var files []regoFile
+			for name, content := range tt.files {
+				files = append(files, regoFile{
+					Name:    name,
+					Content: content,
+					Path:    path.Root("policies").AtMapKey(name),
+					Test:    isRegoTestFile(name),
+				})
+			}
+
+			diags := validateRegoFiles(context.Background(), files)
+
+			if tt.wantErr == "" && diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags.Errors())
+			}
+			if tt.wantErr != "" && !hasDiagnostic(diags.Errors(), tt.wantErr) {
+				t.Fatalf("expected %q error, got %v", tt.wantErr, diags)
+			}
+			if tt.wantWarn != "" && !hasDiagnostic(diags.Warnings(), tt.wantWarn) {
+				t.Fatalf("expected %q warning, got %v", tt.wantWarn, diags)
+			}
+		})
+	}
+}
+
+func hasDiagnostic[T interface{ Summary() string }](diags []T, summary string) bool {
+	for _, d := range diags {
+		if d.Summary() == summary {
+			return true
+		}
+	}
+	return false
+}
+
+func TestEvaluatePolicies(t *testing.T) {
+	policies := map[string]string{
+		"policy.rego": testPolicy + `
+enable_rule["main_only"]
+
+hard_fail["ban_large"]
+
+main_only = "builds must run on main" {
+	data.meta.branch != "main"
+}
+`,
+		"policy_test.rego": "package org\n\ntest_ignored { false }\n",
+	}
+
+	tests := []struct {
+		name       string
+		input      map[string]any
+		branch     string
+		wantStatus string
+		wantHard   int
+		wantSoft   int
+	}{
+		{
+			name:       "pass",
+			input:      map[string]any{"jobs": map[string]any{"build": map[string]any{"resource_class": "medium"}}},
+			branch:     "main",
+			wantStatus: "PASS",
+		},
+		{
+			name:       "soft fail",
+			input:      map[string]any{"jobs": map[string]any{"build": map[string]any{"resource_class": "medium"}}},
+			branch:     "feature",
+			wantStatus: "SOFT_FAIL",
+			wantSoft:   1,
+		},
+		{
+			name:       "hard fail",
+			input:      map[string]any{"jobs": map[string]any{"build": map[string]any{"resource_class": "xlarge"}}},
+			branch:     "feature",
+			wantStatus: "HARD_FAIL",
+			wantHard:   1,
+			wantSoft:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decision, err := evaluatePolicies(context.Background(), policies, tt.input, map[string]any{"branch": tt.branch})
+			if err != nil {
+				t.Fatalf("evaluatePolicies() error = %v", err)
+			}
+			if decision.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", decision.Status, tt.wantStatus)
+			}
+			if len(decision.HardFailures) != tt.wantHard || len(decision.SoftFailures) != tt.wantSoft {
+				t.Errorf("got %d hard and %d soft failures, want %d and %d: %+v",
+					len(decision.HardFailures), len(decision.SoftFailures), tt.wantHard, tt.wantSoft, decision)
+			}
+			if len(decision.EnabledRules) != 2 {
+				t.Errorf("enabled rules = %v, want 2 rules", decision.EnabledRules)
+			}
+		})
+	}
+}
@@ -121,10 +121,16 @@ func (p *CircleCIProvider) Resources(ctx context.Context) []func() resource.Reso
 		NewProjectResource,
 		NewEnvironmentVariableResource,
 		NewCheckoutKeyResource,
+		NewProjectSSHKeyResource,
+		NewProjectAPITokenResource,
 		NewWebhookResource,
 		NewScheduleResource,
+		NewPipelineRunResource,
+		NewWorkflowApprovalResource,
 		NewOIDCTokenResource,
 		NewPolicyResource,
+		NewPolicyBundleResource,
+		NewPolicySettingsResource,
 		NewUsageExportResource,
 		NewRunnerResource,
 		NewRunnerTokenResource,
@@ -135,9 +141,30 @@ func (p *CircleCIProvider) DataSources(ctx context.Context) []func() datasource.
 	return []func() datasource.DataSource{
 		NewContextDataSource,
 		NewProjectDataSource,
+		NewCheckoutKeysDataSource,
+		NewPipelinesDataSource,
+		NewPipelineDataSource,
+		NewWorkflowDataSource,
+		NewJobDataSource,
+		NewJobArtifactsDataSource,
+		NewPendingApprovalsDataSource,
 		NewInsightDataSource,
 		NewOrganizationDataSource,
+		NewCurrentUserDataSource,
+		NewCollaborationsDataSource,
 		NewPoliciesDataSource,
+		NewPolicyDecisionsDataSource,
+		NewPolicyEvaluationDataSource,
+		NewUsageExportDataDataSource,
+		NewRunnerResourceClassesDataSource,
+		NewRunnersDataSource,
+		NewRunnerTasksDataSource,
+		NewInsightsWorkflowsDataSource,
+		NewInsightsJobsDataSource,
+		NewInsightsFlakyTestsDataSource,
+		NewInsightsBranchesDataSource,
+		NewInsightsOrgSummaryDataSource,
+		NewWebhooksDataSource,
 	}
 }
 
@@ -3,14 +3,19 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &CheckoutKeyResource{}
@@ -25,12 +30,14 @@ type CheckoutKeyResource struct {
 }
 
 type CheckoutKeyResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	ProjectSlug types.String `tfsdk:"project_slug"`
-	Type        types.String `tfsdk:"type"`
-	Fingerprint types.String `tfsdk:"fingerprint"`
-	PublicKey   types.String `tfsdk:"public_key"`
-	CreatedAt   types.String `tfsdk:"created_at"`
+	ID            types.String `tfsdk:"id"`
+	ProjectSlug   types.String `tfsdk:"project_slug"`
+	Type          types.String `tfsdk:"type"`
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"`
+	Fingerprint   types.String `tfsdk:"fingerprint"`
+	PublicKey     types.String `tfsdk:"public_key"`
+	Preferred     types.Bool   `tfsdk:"preferred"`
+	CreatedAt     types.String `tfsdk:"created_at"`
 }
 
 // CircleCI API models for checkout keys
@@ -46,6 +53,21 @@ type CreateCheckoutKeyRequest struct {
 	Type string `json:"type"`
 }
 
+// checkoutKeyTypes are the kinds of checkout key CircleCI can create.
+var checkoutKeyTypes = []string{"user-key", "deploy-key"}
+
+// checkoutKeyEndpoint returns the endpoint of a single checkout key. SHA256
+// fingerprints are base64 and may contain '/', so the fingerprint is escaped.
+func checkoutKeyEndpoint(projectSlug, fingerprint string) string {
+	return fmt.Sprintf("/project/%s/checkout-key/%s", EscapeProjectSlug(projectSlug), url.PathEscape(fingerprint))
+}
+
+// listCheckoutKeys returns every checkout key of a project.
+func listCheckoutKeys(ctx context.Context, c *CircleCIClient, projectSlug string) ([]CheckoutKey, error) {
+	endpoint := fmt.Sprintf("/project/%s/checkout-key", EscapeProjectSlug(projectSlug))
+	return GetAllPagesOf[CheckoutKey](ctx, c, endpoint, nil)
+}
+
 func (r *CheckoutKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_checkout_key"
 }
@@ -72,13 +94,29 @@ func (r *CheckoutKeyResource) Schema(ctx context.Context, req resource.SchemaReq
 			"type": schema.StringAttribute{
 				MarkdownDescription: "The type of checkout key. Valid values are 'user-key' and 'deploy-key'.",
 				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(checkoutKeyTypes...),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Take over the project's current preferred key of the same `type` instead of creating a new one, such as the deploy key CircleCI adds when a project is followed. A new key is created when there is none. Defaults to false.",
+			},
 			"fingerprint": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The SSH fingerprint of the checkout key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"preferred": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether this is the key CircleCI uses to check out the project.",
 			},
 			"public_key": schema.StringAttribute{
 				Computed:            true,
@@ -117,24 +155,45 @@ func (r *CheckoutKeyResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	createReq := CreateCheckoutKeyRequest{
-		Type: data.Type.ValueString(),
+	var checkoutKey *CheckoutKey
+	if data.AdoptExisting.ValueBool() {
+		keys, err := listCheckoutKeys(ctx, r.client, data.ProjectSlug.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list checkout keys, got error: %s", err))
+			return
+		}
+
+		for i := range keys {
+			if keys[i].Preferred && keys[i].Type == data.Type.ValueString() {
+				checkoutKey = &keys[i]
+				tflog.Debug(ctx, "adopting existing checkout key", map[string]interface{}{
+					"project_slug": data.ProjectSlug.ValueString(),
+					"fingerprint":  checkoutKey.Fingerprint,
+				})
+				break
+			}
+		}
 	}
 
-	slug := EscapeProjectSlug(data.ProjectSlug.ValueString())
-	endpoint := fmt.Sprintf("/project/%s/checkout-key", slug)
+	if checkoutKey == nil {
+		createReq := CreateCheckoutKeyRequest{
+			Type: data.Type.ValueString(),
+		}
 
-	var checkoutKey CheckoutKey
-	if err := r.client.Post(ctx, endpoint, createReq, &checkoutKey); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create checkout key, got error: %s", err))
-		return
+		slug := EscapeProjectSlug(data.ProjectSlug.ValueString())
+		endpoint := fmt.Sprintf("/project/%s/checkout-key", slug)
+
+		checkoutKey = &CheckoutKey{}
+		if err := r.client.Post(ctx, endpoint, createReq, checkoutKey); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create checkout key, got error: %s", err))
+			return
+		}
 	}
 
 	// Update the model with the response
 	data.ID = types.StringValue(fmt.Sprintf("%s:%s", data.ProjectSlug.ValueString(), checkoutKey.Fingerprint))
 	data.Fingerprint = types.StringValue(checkoutKey.Fingerprint)
-	data.PublicKey = types.StringValue(checkoutKey.PublicKey)
-	data.CreatedAt = types.StringValue(checkoutKey.CreatedAt)
+	mapCheckoutKeyToModel(checkoutKey, &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -147,29 +206,43 @@ func (r *CheckoutKeyResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	slug := EscapeProjectSlug(data.ProjectSlug.ValueString())
-	endpoint := fmt.Sprintf("/project/%s/checkout-key/%s", slug, data.Fingerprint.ValueString())
+	endpoint := checkoutKeyEndpoint(data.ProjectSlug.ValueString(), data.Fingerprint.ValueString())
 
 	var checkoutKey CheckoutKey
 	if err := r.client.Get(ctx, endpoint, &checkoutKey); err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read checkout key, got error: %s", err))
 		return
 	}
 
+	// Imported keys have no adopt_existing in state yet.
+	if data.AdoptExisting.IsNull() {
+		data.AdoptExisting = types.BoolValue(false)
+	}
+
 	// Update the model with the response
-	data.Type = types.StringValue(checkoutKey.Type)
-	data.PublicKey = types.StringValue(checkoutKey.PublicKey)
-	data.CreatedAt = types.StringValue(checkoutKey.CreatedAt)
+	mapCheckoutKeyToModel(&checkoutKey, &data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *CheckoutKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Checkout keys cannot be updated - they need to be recreated
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"CircleCI checkout keys cannot be updated. Changes require destroying and recreating the key.",
-	)
+	// Checkout keys cannot be updated - every key attribute forces a
+	// replacement, so only adopt_existing can change in place.
+	var plan, data CheckoutKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.AdoptExisting = plan.AdoptExisting
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *CheckoutKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -180,10 +253,12 @@ func (r *CheckoutKeyResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	slug := EscapeProjectSlug(data.ProjectSlug.ValueString())
-	endpoint := fmt.Sprintf("/project/%s/checkout-key/%s", slug, data.Fingerprint.ValueString())
+	endpoint := checkoutKeyEndpoint(data.ProjectSlug.ValueString(), data.Fingerprint.ValueString())
 
 	if err := r.client.Delete(ctx, endpoint); err != nil {
+		if IsNotFound(err) {
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete checkout key, got error: %s", err))
 		return
 	}
@@ -204,3 +279,10 @@ func (r *CheckoutKeyResource) ImportState(ctx context.Context, req resource.Impo
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fingerprint"), parts[1])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 }
+
+func mapCheckoutKeyToModel(checkoutKey *CheckoutKey, data *CheckoutKeyResourceModel) {
+	data.Type = types.StringValue(checkoutKey.Type)
+	data.PublicKey = types.StringValue(checkoutKey.PublicKey)
+	data.Preferred = types.BoolValue(checkoutKey.Preferred)
+	data.CreatedAt = types.StringValue(checkoutKey.CreatedAt)
+}
@@ -0,0 +1,534 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &PipelineRunResource{}
+
+const (
+	// pipelineRunDefaultCreateTimeout bounds how long Create waits for the
+	// workflows when no timeouts block is configured.
+	pipelineRunDefaultCreateTimeout = 30 * time.Minute
+)
+
+// pipelineRunPollInterval is how often Create polls a running pipeline. It is
+// a variable so that tests can shorten it.
+var pipelineRunPollInterval = 10 * time.Second
+
+// workflowTerminalStatuses are the workflow statuses that will not change
+// any more, mapped to whether they count as a failure.
+var workflowTerminalStatuses = map[string]bool{
+	"success":      false,
+	"not_run":      false,
+	"failed":       true,
+	"error":        true,
+	"canceled":     true,
+	"unauthorized": true,
+}
+
+func NewPipelineRunResource() resource.Resource {
+	return &PipelineRunResource{}
+}
+
+// PipelineRunResource triggers a pipeline when it is created. Pipelines
+// cannot be deleted, so destroying the resource only forgets it.
+type PipelineRunResource struct {
+	client *CircleCIClient
+}
+
+type PipelineRunResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	ProjectSlug types.String   `tfsdk:"project_slug"`
+	Branch      types.String   `tfsdk:"branch"`
+	Tag         types.String   `tfsdk:"tag"`
+	Parameters  types.Dynamic  `tfsdk:"parameters"`
+	Triggers    types.Map      `tfsdk:"triggers"`
+	Wait        types.Bool     `tfsdk:"wait"`
+	Number      types.Int64    `tfsdk:"number"`
+	State       types.String   `tfsdk:"state"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+	Workflows   types.List     `tfsdk:"workflows"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+type PipelineRunWorkflowModel struct {
+	ID     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Status types.String `tfsdk:"status"`
+}
+
+var pipelineRunWorkflowAttrTypes = map[string]attr.Type{
+	"id":     types.StringType,
+	"name":   types.StringType,
+	"status": types.StringType,
+}
+
+// CircleCI API models for pipelines
+type TriggerPipelineRequest struct {
+	Branch     string                 `json:"branch,omitempty"`
+	Tag        string                 `json:"tag,omitempty"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+}
+
+type PipelineAPI struct {
+	ID          string             `json:"id"`
+	ProjectSlug string             `json:"project_slug"`
+	Number      int64              `json:"number"`
+	State       string             `json:"state"`
+	CreatedAt   string             `json:"created_at"`
+	UpdatedAt   string             `json:"updated_at"`
+	Errors      []PipelineErrorAPI `json:"errors"`
+	VCS         PipelineVCSAPI     `json:"vcs"`
+	Trigger     PipelineTriggerAPI `json:"trigger"`
+}
+
+type PipelineVCSAPI struct {
+	Branch   string `json:"branch"`
+	Tag      string `json:"tag"`
+	Revision string `json:"revision"`
+}
+
+type PipelineTriggerAPI struct {
+	Type  string `json:"type"`
+	Actor struct {
+		Login string `json:"login"`
+	} `json:"actor"`
+}
+
+type PipelineErrorAPI struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+type WorkflowAPI struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Status         string `json:"status"`
+	PipelineID     string `json:"pipeline_id"`
+	PipelineNumber int64  `json:"pipeline_number"`
+	ProjectSlug    string `json:"project_slug"`
+	CreatedAt      string `json:"created_at"`
+	StoppedAt      string `json:"stopped_at"`
+}
+
+func (r *PipelineRunResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline_run"
+}
+
+func (r *PipelineRunResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Pipeline Run resource. Triggers a pipeline when created and optionally waits for its workflows to finish. Change `triggers` to run the pipeline again.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the pipeline.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_slug": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The project slug in the form 'vcs-slug/org-name/repo-name'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The branch to run the pipeline on. Defaults to the project's default branch.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("tag")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tag": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The tag to run the pipeline on.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parameters": schema.DynamicAttribute{
+				Optional:            true,
+				MarkdownDescription: "Pipeline parameters to pass to the pipeline, as an object whose values are strings, booleans or numbers. Values keep their type, so `boolean` and `integer` parameters can be set.",
+				PlanModifiers: []planmodifier.Dynamic{
+					dynamicplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values that trigger a new pipeline run when they change, such as the ID of the infrastructure the pipeline tests.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Wait for every workflow of the pipeline to finish and fail if any of them does not succeed. Defaults to false.",
+			},
+			"number": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of the pipeline.",
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The state of the pipeline (created, errored, setup-pending, setup, pending).",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date and time the pipeline was created.",
+			},
+			"workflows": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The workflows of the pipeline as of the last apply.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the workflow.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the workflow.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The status of the workflow.",
+						},
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *PipelineRunResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PipelineRunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PipelineRunResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, pipelineRunDefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters, err := pipelineParameters(data.Parameters)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("parameters"), "Invalid Pipeline Parameters", err.Error())
+		return
+	}
+
+	triggerReq := TriggerPipelineRequest{
+		Branch:     data.Branch.ValueString(),
+		Tag:        data.Tag.ValueString(),
+		Parameters: parameters,
+	}
+
+	endpoint := fmt.Sprintf("/project/%s/pipeline", EscapeProjectSlug(data.ProjectSlug.ValueString()))
+
+	var pipeline PipelineAPI
+	if err := r.client.Post(ctx, endpoint, triggerReq, &pipeline); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to trigger pipeline, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "triggered pipeline", map[string]interface{}{
+		"id":     pipeline.ID,
+		"number": pipeline.Number,
+	})
+
+	var workflows []WorkflowAPI
+	var waitErr error
+	if data.Wait.ValueBool() {
+		workflows, waitErr = r.waitForPipeline(ctx, &pipeline, createTimeout)
+	}
+
+	data.ID = types.StringValue(pipeline.ID)
+	data.Number = types.Int64Value(pipeline.Number)
+	data.State = types.StringValue(pipeline.State)
+	data.CreatedAt = types.StringValue(pipeline.CreatedAt)
+	resp.Diagnostics.Append(setPipelineRunWorkflows(ctx, workflows, &data)...)
+
+	// Save the pipeline even on failure so that it is tainted and run again.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if waitErr != nil {
+		resp.Diagnostics.AddError("Failed to wait for pipeline", waitErr.Error())
+		return
+	}
+
+	if failed := failedWorkflows(workflows); len(failed) > 0 {
+		resp.Diagnostics.AddError(
+			"Pipeline Failed",
+			fmt.Sprintf("Pipeline %d finished with failed workflows: %s", pipeline.Number, strings.Join(failed, ", ")),
+		)
+	}
+}
+
+func (r *PipelineRunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PipelineRunResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var pipeline PipelineAPI
+	if err := r.client.Get(ctx, fmt.Sprintf("/pipeline/%s", data.ID.ValueString()), &pipeline); err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read pipeline, got error: %s", err))
+		return
+	}
+
+	// The workflows are a record of the run; a later refresh does not
+	// re-trigger anything, so only the pipeline state is refreshed.
+	data.Number = types.Int64Value(pipeline.Number)
+	data.State = types.StringValue(pipeline.State)
+	data.CreatedAt = types.StringValue(pipeline.CreatedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PipelineRunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Everything but wait and the timeouts forces a new run; those only
+	// matter on create.
+	var plan, data PipelineRunResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Wait = plan.Wait
+	data.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PipelineRunResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Pipelines cannot be deleted; removing the resource from state is enough.
+}
+
+// waitForPipeline polls the pipeline until all of its workflows have
+// finished and returns them.
+func (r *PipelineRunResource) waitForPipeline(ctx context.Context, pipeline *PipelineAPI, timeout time.Duration) ([]WorkflowAPI, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(pipelineRunPollInterval)
+	defer ticker.Stop()
+
+	// The last polled workflows are returned on failure too, so that the
+	// tainted state shows how far the pipeline got.
+	var workflows []WorkflowAPI
+	timedOut := func() error {
+		return fmt.Errorf("pipeline %d still running after %s", pipeline.Number, timeout)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return workflows, timedOut()
+		case <-ticker.C:
+		}
+
+		if err := r.client.Get(ctx, fmt.Sprintf("/pipeline/%s", pipeline.ID), pipeline); err != nil {
+			if ctx.Err() == context.DeadlineExceeded {
+				return workflows, timedOut()
+			}
+			return workflows, err
+		}
+
+		if pipeline.State == "errored" {
+			messages := make([]string, len(pipeline.Errors))
+			for i, e := range pipeline.Errors {
+				messages[i] = e.Message
+			}
+			return workflows, fmt.Errorf("pipeline %d errored: %s", pipeline.Number, strings.Join(messages, "; "))
+		}
+
+		if pipeline.State != "created" {
+			continue
+		}
+
+		polled, err := GetAllPagesOf[WorkflowAPI](ctx, r.client, fmt.Sprintf("/pipeline/%s/workflow", pipeline.ID), nil)
+		if err != nil {
+			if ctx.Err() == context.DeadlineExceeded {
+				return workflows, timedOut()
+			}
+			return workflows, err
+		}
+		workflows = polled
+
+		if workflowsFinished(workflows) {
+			return workflows, nil
+		}
+
+		// An approval would hold the wait until the timeout; report it now.
+		if held := heldWorkflows(workflows); len(held) > 0 {
+			return workflows, fmt.Errorf("pipeline %d is waiting on approval in workflows %s; approve them or set wait to false", pipeline.Number, strings.Join(held, ", "))
+		}
+
+		tflog.Debug(ctx, "waiting for pipeline workflows", map[string]interface{}{
+			"id":        pipeline.ID,
+			"workflows": len(workflows),
+		})
+	}
+}
+
+// workflowsFinished reports whether the pipeline has workflows and all of
+// them are in a terminal status.
+func workflowsFinished(workflows []WorkflowAPI) bool {
+	if len(workflows) == 0 {
+		return false
+	}
+
+	for _, workflow := range workflows {
+		if _, ok := workflowTerminalStatuses[workflow.Status]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// heldWorkflows returns the names of the workflows waiting on an approval
+// job.
+func heldWorkflows(workflows []WorkflowAPI) []string {
+	var held []string
+	for _, workflow := range workflows {
+		if workflow.Status == "on_hold" {
+			held = append(held, workflow.Name)
+		}
+	}
+	return held
+}
+
+// failedWorkflows returns the names and statuses of the workflows that did
+// not succeed.
+func failedWorkflows(workflows []WorkflowAPI) []string {
+	var failed []string
+	for _, workflow := range workflows {
+		if workflowTerminalStatuses[workflow.Status] {
+			failed = append(failed, fmt.Sprintf("%s (%s)", workflow.Name, workflow.Status))
+		}
+	}
+	return failed
+}
+
+// pipelineParameters converts the parameters attribute into the JSON values
+// CircleCI expects, keeping booleans and numbers typed.
+func pipelineParameters(value types.Dynamic) (map[string]interface{}, error) {
+	if value.IsNull() || value.IsUnderlyingValueNull() {
+		return nil, nil
+	}
+
+	var elements map[string]attr.Value
+	switch v := value.UnderlyingValue().(type) {
+	case types.Object:
+		elements = v.Attributes()
+	case types.Map:
+		elements = v.Elements()
+	default:
+		return nil, fmt.Errorf("parameters must be an object or a map, got %s", value.UnderlyingValue().Type(context.Background()))
+	}
+
+	parameters := make(map[string]interface{}, len(elements))
+	for name, element := range elements {
+		if element.IsNull() || element.IsUnknown() {
+			return nil, fmt.Errorf("parameter %q has no value", name)
+		}
+
+		switch v := element.(type) {
+		case types.String:
+			parameters[name] = v.ValueString()
+		case types.Bool:
+			parameters[name] = v.ValueBool()
+		case types.Number:
+			number := v.ValueBigFloat()
+			if number.IsInt() {
+				i, _ := number.Int64()
+				parameters[name] = i
+			} else {
+				f, _ := number.Float64()
+				parameters[name] = f
+			}
+		default:
+			return nil, fmt.Errorf("parameter %q must be a string, boolean or number, got %s", name, element.Type(context.Background()))
+		}
+	}
+
+	return parameters, nil
+}
+
+func setPipelineRunWorkflows(ctx context.Context, workflows []WorkflowAPI, data *PipelineRunResourceModel) diag.Diagnostics {
+	models := make([]PipelineRunWorkflowModel, len(workflows))
+	for i, workflow := range workflows {
+		models[i] = PipelineRunWorkflowModel{
+			ID:     types.StringValue(workflow.ID),
+			Name:   types.StringValue(workflow.Name),
+			Status: types.StringValue(workflow.Status),
+		}
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: pipelineRunWorkflowAttrTypes}, models)
+	data.Workflows = list
+	return diags
+}
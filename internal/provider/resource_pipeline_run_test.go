@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWorkflowsFinished(t *testing.T) {
+	tests := []struct {
+		name      string
+		workflows []WorkflowAPI
+		want      bool
+	}{
+		{name: "no workflows yet", want: false},
+		{name: "running", workflows: []WorkflowAPI{{Status: "success"}, {Status: "running"}}, want: false},
+		{name: "on hold", workflows: []WorkflowAPI{{Status: "on_hold"}}, want: false},
+		{name: "finished", workflows: []WorkflowAPI{{Status: "success"}, {Status: "failed"}, {Status: "not_run"}}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := workflowsFinished(tt.workflows); got != tt.want {
+				t.Errorf("workflowsFinished() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFailedWorkflows(t *testing.T) {
+	workflows := []WorkflowAPI{
+		{Name: "build", Status: "success"},
+		{Name: "smoke-test", Status: "failed"},
+		{Name: "deploy", Status: "canceled"},
+		{Name: "docs", Status: "not_run"},
+	}
+
+	want := []string{"smoke-test (failed)", "deploy (canceled)"}
+	if got := failedWorkflows(workflows); !reflect.DeepEqual(got, want) {
+		t.Errorf("failedWorkflows() = %v, want %v", got, want)
+	}
+}
+
+func TestHeldWorkflows(t *testing.T) {
+	workflows := []WorkflowAPI{
+		{Name: "build", Status: "success"},
+		{Name: "release", Status: "on_hold"},
+		{Name: "test", Status: "running"},
+	}
+
+	want := []string{"release"}
+	if got := heldWorkflows(workflows); !reflect.DeepEqual(got, want) {
+		t.Errorf("heldWorkflows() = %v, want %v", got, want)
+	}
+}
+
+func TestWaitForPipelineOnHold(t *testing.T) {
+	defer func(interval time.Duration) { pipelineRunPollInterval = interval }(pipelineRunPollInterval)
+	pipelineRunPollInterval = time.Millisecond
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/pipeline/p1":
+			fmt.Fprint(w, `{"id":"p1","number":7,"state":"created"}`)
+		case "/pipeline/p1/workflow":
+			fmt.Fprint(w, `{"items":[{"id":"w1","name":"build","status":"success"},{"id":"w2","name":"release","status":"on_hold"}]}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	r := &PipelineRunResource{client: &CircleCIClient{BaseURL: server.URL, HTTPClient: server.Client()}}
+	pipeline := &PipelineAPI{ID: "p1"}
+
+	workflows, err := r.waitForPipeline(context.Background(), pipeline, time.Minute)
+	if err == nil || !strings.Contains(err.Error(), "release") {
+		t.Fatalf("waitForPipeline() error = %v, want an error naming the held workflow", err)
+	}
+	if len(workflows) != 2 {
+		t.Errorf("got %d workflows, want both workflows for the state", len(workflows))
+	}
+}
+
+func TestWaitForPipelineTimeout(t *testing.T) {
+	defer func(interval time.Duration) { pipelineRunPollInterval = interval }(pipelineRunPollInterval)
+	pipelineRunPollInterval = time.Millisecond
+
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/pipeline/p1":
+			polls++
+			if polls > 1 {
+				// Hang until the wait times out in the middle of the request.
+				<-r.Context().Done()
+				return
+			}
+			fmt.Fprint(w, `{"id":"p1","number":7,"state":"created"}`)
+		case "/pipeline/p1/workflow":
+			fmt.Fprint(w, `{"items":[{"id":"w1","name":"build","status":"running"}]}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	r := &PipelineRunResource{client: &CircleCIClient{BaseURL: server.URL, HTTPClient: server.Client()}}
+	pipeline := &PipelineAPI{ID: "p1"}
+
+	workflows, err := r.waitForPipeline(context.Background(), pipeline, 200*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "still running after") {
+		t.Fatalf("waitForPipeline() error = %v, want the timeout message", err)
+	}
+	if len(workflows) != 1 || workflows[0].Name != "build" {
+		t.Errorf("workflows = %+v, want the last polled workflows", workflows)
+	}
+}
+
+func TestPipelineParameters(t *testing.T) {
+	object, diags := types.ObjectValue(
+		map[string]attr.Type{
+			"deploy":   types.BoolType,
+			"replicas": types.NumberType,
+			"ratio":    types.NumberType,
+			"env":      types.StringType,
+		},
+		map[string]attr.Value{
+			"deploy":   types.BoolValue(true),
+			"replicas": types.NumberValue(big.NewFloat(3)),
+			"ratio":    types.NumberValue(big.NewFloat(0.5)),
+			"env":      types.StringValue("prod"),
+		},
+	)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	got, err := pipelineParameters(types.DynamicValue(object))
+	if err != nil {
+		t.Fatalf("pipelineParameters() error = %v", err)
+	}
+	want := map[string]interface{}{"deploy": true, "replicas": int64(3), "ratio": 0.5, "env": "prod"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("pipelineParameters() = %#v, want %#v", got, want)
+	}
+
+	if got, err := pipelineParameters(types.DynamicNull()); err != nil || got != nil {
+		t.Errorf("pipelineParameters(null) = %v, %v, want nil, nil", got, err)
+	}
+
+	list := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")})
+	nested := types.ObjectValueMust(map[string]attr.Type{"list": list.Type(nil)}, map[string]attr.Value{"list": list})
+	if _, err := pipelineParameters(types.DynamicValue(nested)); err == nil {
+		t.Error("expected an error for a list parameter")
+	}
+	if _, err := pipelineParameters(types.DynamicValue(types.StringValue("x"))); err == nil {
+		t.Error("expected an error for parameters that are not an object")
+	}
+}
@@ -17,6 +17,7 @@ import (
 
 var _ resource.Resource = &PolicyResource{}
 var _ resource.ResourceWithImportState = &PolicyResource{}
+var _ resource.ResourceWithValidateConfig = &PolicyResource{}
 
 func NewPolicyResource() resource.Resource {
 	return &PolicyResource{}
@@ -31,6 +32,7 @@ type PolicyResourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 	Content     types.String `tfsdk:"content"`
+	Tests       types.Map    `tfsdk:"tests"`
 	OrgID       types.String `tfsdk:"org_id"`
 	Enabled     types.Bool   `tfsdk:"enabled"`
 	CreatedAt   types.String `tfsdk:"created_at"`
@@ -82,7 +84,12 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"content": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "The policy content in OPA Rego format.",
+				MarkdownDescription: "The policy content in OPA Rego format. It is parsed and compiled during validation and must declare `package org` and a `policy_name`.",
+			},
+			"tests": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Map of `*_test.rego` file name to Rego test content. The tests run against `content` during validation and are not uploaded.",
 			},
 			"org_id": schema.StringAttribute{
 				Required:            true,
@@ -302,6 +309,58 @@ func (r *PolicyResource) ImportState(ctx context.Context, req resource.ImportSta
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), policyID)...)
 }
 
+func (r *PolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PolicyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Content.IsUnknown() || data.Tests.IsUnknown() {
+		return
+	}
+
+	fileName := "policy.rego"
+	if !data.Name.IsUnknown() && !data.Name.IsNull() {
+		fileName = data.Name.ValueString() + ".rego"
+	}
+
+	files := []regoFile{{
+		Name:    fileName,
+		Content: data.Content.ValueString(),
+		Path:    path.Root("content"),
+	}}
+
+	var tests map[string]types.String
+	resp.Diagnostics.Append(data.Tests.ElementsAs(ctx, &tests, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, content := range tests {
+		if content.IsUnknown() {
+			return
+		}
+		if !isRegoTestFile(name) {
+			resp.Diagnostics.AddAttributeError(path.Root("tests").AtMapKey(name), "Invalid Test File Name",
+				fmt.Sprintf("Test file names must end in `_test.rego`, got %q.", name))
+			continue
+		}
+		files = append(files, regoFile{
+			Name:    name,
+			Content: content.ValueString(),
+			Path:    path.Root("tests").AtMapKey(name),
+			Test:    true,
+		})
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateRegoFiles(ctx, files)...)
+}
+
 func (r *PolicyResource) mapPolicyToModel(policy *PolicyAPI, data *PolicyResourceModel) {
 	data.ID = types.StringValue(policy.ID)
 	data.Name = types.StringValue(policy.Name)
@@ -0,0 +1,371 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &PolicyBundleResource{}
+var _ resource.ResourceWithImportState = &PolicyBundleResource{}
+var _ resource.ResourceWithModifyPlan = &PolicyBundleResource{}
+var _ resource.ResourceWithValidateConfig = &PolicyBundleResource{}
+
+// defaultPolicyContext is the only policy context CircleCI currently supports.
+const defaultPolicyContext = "config"
+
+func NewPolicyBundleResource() resource.Resource {
+	return &PolicyBundleResource{}
+}
+
+type PolicyBundleResource struct {
+	client *CircleCIClient
+}
+
+type PolicyBundleResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	OwnerID  types.String `tfsdk:"owner_id"`
+	Context  types.String `tfsdk:"context"`
+	Policies types.Map    `tfsdk:"policies"`
+}
+
+// CircleCI API models for policy bundles
+type PolicyBundlePolicyAPI struct {
+	Name      string `json:"name"`
+	Context   string `json:"context"`
+	Content   string `json:"content"`
+	CreatedAt string `json:"created_at"`
+	CreatedBy string `json:"created_by"`
+}
+
+type PolicyBundleRequest struct {
+	Policies map[string]string `json:"policies"`
+}
+
+func (r *PolicyBundleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_bundle"
+}
+
+func (r *PolicyBundleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Policy Bundle resource. Manages the complete set of config policies of an owner as a single bundle of Rego files. " +
+			"Every upload replaces the whole bundle, so policies not listed here are removed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the bundle, in the form 'owner_id:context'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The organization ID that owns the policies.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"context": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultPolicyContext),
+				MarkdownDescription: "The policy context. Defaults to 'config'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policies": schema.MapAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "Map of policy file name to Rego content. The bundle is uploaded atomically. " +
+					"Files named `*_test.rego` are run as policy tests during validation and are not uploaded.",
+			},
+		},
+	}
+}
+
+func (r *PolicyBundleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PolicyBundleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PolicyBundleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var policies map[string]string
+	resp.Diagnostics.Append(data.Policies.ElementsAs(ctx, &policies, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.uploadBundle(ctx, data.OwnerID.ValueString(), data.Context.ValueString(), policies); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upload policy bundle, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", data.OwnerID.ValueString(), data.Context.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PolicyBundleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PolicyBundleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var bundle map[string]PolicyBundlePolicyAPI
+	if err := r.client.Get(ctx, policyBundleEndpoint(data.OwnerID.ValueString(), data.Context.ValueString()), &bundle); err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read policy bundle, got error: %s", err))
+		return
+	}
+
+	// Test files are never uploaded, so carry them over from state.
+	policies := make(map[string]string, len(bundle))
+	if !data.Policies.IsNull() {
+		resp.Diagnostics.Append(data.Policies.ElementsAs(ctx, &policies, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for name := range policies {
+			if !isRegoTestFile(name) {
+				delete(policies, name)
+			}
+		}
+	}
+	for name, policy := range bundle {
+		policies[name] = policy.Content
+	}
+
+	policiesMap, diags := types.MapValueFrom(ctx, types.StringType, policies)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Policies = policiesMap
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", data.OwnerID.ValueString(), data.Context.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PolicyBundleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PolicyBundleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var policies map[string]string
+	resp.Diagnostics.Append(data.Policies.ElementsAs(ctx, &policies, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.uploadBundle(ctx, data.OwnerID.ValueString(), data.Context.ValueString(), policies); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upload policy bundle, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PolicyBundleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PolicyBundleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Uploading an empty bundle removes every policy.
+	if err := r.uploadBundle(ctx, data.OwnerID.ValueString(), data.Context.ValueString(), map[string]string{}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete policy bundle, got error: %s", err))
+		return
+	}
+}
+
+func (r *PolicyBundleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: "owner_id" or "owner_id:context"
+	ownerID, policyContext, found := strings.Cut(req.ID, ":")
+	if !found {
+		policyContext = defaultPolicyContext
+	}
+
+	if ownerID == "" || policyContext == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Import ID must be in format 'owner_id' or 'owner_id:context', got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s:%s", ownerID, policyContext))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner_id"), ownerID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("context"), policyContext)...)
+}
+
+func (r *PolicyBundleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan PolicyBundleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Policies.IsUnknown() {
+		return
+	}
+
+	planned := map[string]string{}
+	resp.Diagnostics.Append(plan.Policies.ElementsAs(ctx, &planned, false)...)
+
+	current := map[string]string{}
+	if !req.State.Raw.IsNull() {
+		var state PolicyBundleResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(state.Policies.ElementsAs(ctx, &current, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if summary := diffPolicyBundles(bundlePolicies(current), bundlePolicies(planned)); summary != "" {
+		resp.Diagnostics.AddAttributeWarning(path.Root("policies"), "Policy Bundle Changes", summary)
+	}
+}
+
+func (r *PolicyBundleResource) uploadBundle(ctx context.Context, ownerID, policyContext string, policies map[string]string) error {
+	return r.client.Post(ctx, policyBundleEndpoint(ownerID, policyContext), PolicyBundleRequest{Policies: bundlePolicies(policies)}, nil)
+}
+
+func (r *PolicyBundleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PolicyBundleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Policies.IsNull() || data.Policies.IsUnknown() {
+		return
+	}
+
+	var policies map[string]types.String
+	resp.Diagnostics.Append(data.Policies.ElementsAs(ctx, &policies, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	files := make([]regoFile, 0, len(policies))
+	for name, content := range policies {
+		if content.IsUnknown() {
+			return
+		}
+		files = append(files, regoFile{
+			Name:    name,
+			Content: content.ValueString(),
+			Path:    path.Root("policies").AtMapKey(name),
+			Test:    isRegoTestFile(name),
+		})
+	}
+	if len(files) == 0 {
+		return
+	}
+
+	// Keep the diagnostics in a stable order.
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+
+	resp.Diagnostics.Append(validateRegoFiles(ctx, files)...)
+}
+
+// bundlePolicies returns the policies that are uploaded, leaving out tests.
+func bundlePolicies(policies map[string]string) map[string]string {
+	uploaded := make(map[string]string, len(policies))
+	for name, content := range policies {
+		if !isRegoTestFile(name) {
+			uploaded[name] = content
+		}
+	}
+	return uploaded
+}
+
+func policyBundleEndpoint(ownerID, policyContext string) string {
+	return fmt.Sprintf("/owner/%s/context/%s/policy-bundle", ownerID, policyContext)
+}
+
+// diffPolicyBundles describes the policies created, modified and deleted when
+// going from current to planned. It returns an empty string if nothing changes.
+func diffPolicyBundles(current, planned map[string]string) string {
+	var created, modified, deleted []string
+
+	for name, content := range planned {
+		old, ok := current[name]
+		switch {
+		case !ok:
+			created = append(created, name)
+		case old != content:
+			modified = append(modified, name)
+		}
+	}
+	for name := range current {
+		if _, ok := planned[name]; !ok {
+			deleted = append(deleted, name)
+		}
+	}
+
+	var lines []string
+	for _, group := range []struct {
+		label string
+		names []string
+	}{
+		{"created", created},
+		{"modified", modified},
+		{"deleted", deleted},
+	} {
+		if len(group.names) == 0 {
+			continue
+		}
+		sort.Strings(group.names)
+		lines = append(lines, fmt.Sprintf("%s: %s", group.label, strings.Join(group.names, ", ")))
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &PolicySettingsResource{}
+var _ resource.ResourceWithImportState = &PolicySettingsResource{}
+
+func NewPolicySettingsResource() resource.Resource {
+	return &PolicySettingsResource{}
+}
+
+type PolicySettingsResource struct {
+	client *CircleCIClient
+}
+
+type PolicySettingsResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	OwnerID types.String `tfsdk:"owner_id"`
+	Context types.String `tfsdk:"context"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
+
+// CircleCI API models for policy decision settings
+type PolicySettingsAPI struct {
+	Enabled bool `json:"enabled"`
+}
+
+func (r *PolicySettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_settings"
+}
+
+func (r *PolicySettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Policy Settings resource. Turns config policy enforcement on or off for an owner and context. " +
+			"Destroying the resource disables enforcement.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the settings, in the form 'owner_id:context'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The organization ID that owns the policies.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"context": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultPolicyContext),
+				MarkdownDescription: "The policy context. Defaults to 'config'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Required:            true,
+				MarkdownDescription: "Whether policy decisions are enforced.",
+			},
+		},
+	}
+}
+
+func (r *PolicySettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PolicySettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PolicySettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var settings PolicySettingsAPI
+	endpoint := policySettingsEndpoint(data.OwnerID.ValueString(), data.Context.ValueString())
+	if err := r.client.Patch(ctx, endpoint, PolicySettingsAPI{Enabled: data.Enabled.ValueBool()}, &settings); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update policy settings, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", data.OwnerID.ValueString(), data.Context.ValueString()))
+	data.Enabled = types.BoolValue(settings.Enabled)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PolicySettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PolicySettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var settings PolicySettingsAPI
+	if err := r.client.Get(ctx, policySettingsEndpoint(data.OwnerID.ValueString(), data.Context.ValueString()), &settings); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read policy settings, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", data.OwnerID.ValueString(), data.Context.ValueString()))
+	data.Enabled = types.BoolValue(settings.Enabled)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PolicySettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PolicySettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var settings PolicySettingsAPI
+	endpoint := policySettingsEndpoint(data.OwnerID.ValueString(), data.Context.ValueString())
+	if err := r.client.Patch(ctx, endpoint, PolicySettingsAPI{Enabled: data.Enabled.ValueBool()}, &settings); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update policy settings, got error: %s", err))
+		return
+	}
+
+	data.Enabled = types.BoolValue(settings.Enabled)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PolicySettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PolicySettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := policySettingsEndpoint(data.OwnerID.ValueString(), data.Context.ValueString())
+	if err := r.client.Patch(ctx, endpoint, PolicySettingsAPI{Enabled: false}, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable policy decisions, got error: %s", err))
+		return
+	}
+}
+
+func (r *PolicySettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: "owner_id" or "owner_id:context"
+	ownerID, policyContext, found := strings.Cut(req.ID, ":")
+	if !found {
+		policyContext = defaultPolicyContext
+	}
+
+	if ownerID == "" || policyContext == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Import ID must be in format 'owner_id' or 'owner_id:context', got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s:%s", ownerID, policyContext))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner_id"), ownerID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("context"), policyContext)...)
+}
+
+func policySettingsEndpoint(ownerID, policyContext string) string {
+	return fmt.Sprintf("/owner/%s/context/%s/decision/settings", ownerID, policyContext)
+}
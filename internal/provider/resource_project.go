@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -31,6 +32,7 @@ type ProjectResourceModel struct {
 	Organization types.String `tfsdk:"organization"`
 	VcsURL       types.String `tfsdk:"vcs_url"`
 	VcsType      types.String `tfsdk:"vcs_type"`
+	VcsInfo      types.Object `tfsdk:"vcs_info"`
 }
 
 // CircleCI API models for projects
@@ -48,6 +50,31 @@ type VcsInfo struct {
 	DefaultBranch string `json:"default_branch"`
 }
 
+var vcsInfoAttrTypes = map[string]attr.Type{
+	"vcs_url":        types.StringType,
+	"provider":       types.StringType,
+	"default_branch": types.StringType,
+}
+
+// newVcsInfoObject converts the VCS details of a project into the vcs_info
+// attribute shared by the project resource and data source.
+func newVcsInfoObject(info VcsInfo) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(vcsInfoAttrTypes, map[string]attr.Value{
+		"vcs_url":        types.StringValue(info.VcsURL),
+		"provider":       types.StringValue(info.Provider),
+		"default_branch": types.StringValue(info.DefaultBranch),
+	})
+}
+
+// lookupProjectID resolves a project slug to the project's unique identifier.
+func lookupProjectID(ctx context.Context, c *CircleCIClient, slug string) (string, error) {
+	var project Project
+	if err := c.Get(ctx, fmt.Sprintf("/project/%s", EscapeProjectSlug(slug)), &project); err != nil {
+		return "", err
+	}
+	return project.ID, nil
+}
+
 func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_project"
 }
@@ -65,7 +92,7 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"slug": schema.StringAttribute{
-				MarkdownDescription: "The project slug in the form 'vcs-slug/org-name/repo-name' (e.g., 'gh/circleci/circleci-docs').",
+				MarkdownDescription: "The project slug in the form 'vcs-slug/org-name/repo-name' (e.g., 'gh/circleci/circleci-docs'), or 'circleci/org-id/project-id' for GitHub App and GitLab projects.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -87,6 +114,24 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Computed:            true,
 				MarkdownDescription: "The version control system type (e.g., 'github', 'bitbucket').",
 			},
+			"vcs_info": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Details of the repository the project builds.",
+				Attributes: map[string]schema.Attribute{
+					"vcs_url": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The URL of the repository.",
+					},
+					"provider": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The version control system provider.",
+					},
+					"default_branch": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "The default branch of the repository.",
+					},
+				},
+			},
 		},
 	}
 }
@@ -167,6 +212,10 @@ func (r *ProjectResource) readProject(ctx context.Context, data *ProjectResource
 	data.Organization = types.StringValue(project.Organization)
 	data.VcsURL = types.StringValue(project.VcsInfo.VcsURL)
 	data.VcsType = types.StringValue(project.VcsInfo.Provider)
+
+	vcsInfo, diags := newVcsInfoObject(project.VcsInfo)
+	diagnostics.Append(diags...)
+	data.VcsInfo = vcsInfo
 }
 
 func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &ProjectAPITokenResource{}
+var _ resource.ResourceWithImportState = &ProjectAPITokenResource{}
+
+func NewProjectAPITokenResource() resource.Resource {
+	return &ProjectAPITokenResource{}
+}
+
+// ProjectAPITokenResource manages project-scoped API tokens. They are only
+// exposed by the CircleCI API v1.1.
+type ProjectAPITokenResource struct {
+	client *CircleCIClient
+}
+
+type ProjectAPITokenResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ProjectSlug types.String `tfsdk:"project_slug"`
+	Label       types.String `tfsdk:"label"`
+	Scope       types.String `tfsdk:"scope"`
+	Token       types.String `tfsdk:"token"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+// CircleCI API v1.1 models for project API tokens
+type ProjectAPITokenAPI struct {
+	ID    string `json:"id"`
+	Label string `json:"label"`
+	Scope string `json:"scope"`
+	Time  string `json:"time"`
+	// Token is only returned when the token is created.
+	Token string `json:"token,omitempty"`
+}
+
+type CreateProjectAPITokenRequest struct {
+	Label string `json:"label"`
+	Scope string `json:"scope"`
+}
+
+// projectAPITokenScopes are the permissions a project API token can have.
+var projectAPITokenScopes = []string{"status", "view-builds", "all"}
+
+func (r *ProjectAPITokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_api_token"
+}
+
+func (r *ProjectAPITokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Project API Token resource. Project API tokens give integrations access to a single project.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the token.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_slug": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The project slug in the form 'vcs-slug/org-name/repo-name'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "A label describing what the token is used for.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scope": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The permissions of the token. One of 'status', 'view-builds' or 'all'.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(projectAPITokenScopes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The token. Only available for tokens created by Terraform; it is null after an import.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The date and time the token was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectAPITokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProjectAPITokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectAPITokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := CreateProjectAPITokenRequest{
+		Label: data.Label.ValueString(),
+		Scope: data.Scope.ValueString(),
+	}
+
+	endpoint := fmt.Sprintf("/project/%s/token", V1ProjectPath(data.ProjectSlug.ValueString()))
+
+	var token ProjectAPITokenAPI
+	if err := r.client.PostV1(ctx, endpoint, createReq, &token); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project API token, got error: %s", err))
+		return
+	}
+
+	// The token is only returned now; keep it in state.
+	data.Token = types.StringValue(token.Token)
+	mapProjectAPITokenToModel(&token, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectAPITokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectAPITokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := findProjectAPIToken(ctx, r.client, data.ProjectSlug.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list project API tokens, got error: %s", err))
+		return
+	}
+
+	if token == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapProjectAPITokenToModel(token, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectAPITokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Project API tokens cannot be updated - they need to be recreated
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"CircleCI project API tokens cannot be updated. Changes require revoking and recreating the token.",
+	)
+}
+
+func (r *ProjectAPITokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectAPITokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	endpoint := fmt.Sprintf("/project/%s/token/%s", V1ProjectPath(data.ProjectSlug.ValueString()), data.ID.ValueString())
+	if err := r.client.DeleteV1(ctx, endpoint, nil); err != nil {
+		if IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke project API token, got error: %s", err))
+		return
+	}
+}
+
+func (r *ProjectAPITokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectSlug, id, ok := parseProjectAPITokenImportID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: project_slug:id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_slug"), projectSlug)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// parseProjectAPITokenImportID splits an import identifier of the form
+// "project_slug:id".
+func parseProjectAPITokenImportID(importID string) (projectSlug, id string, ok bool) {
+	parts := strings.SplitN(importID, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// findProjectAPIToken returns the token of the project with the given id, or
+// nil if the token or the project is gone. The v1.1 API has no endpoint for
+// a single token, so the tokens of the project are listed.
+func findProjectAPIToken(ctx context.Context, c *CircleCIClient, projectSlug, id string) (*ProjectAPITokenAPI, error) {
+	var tokens []ProjectAPITokenAPI
+	endpoint := fmt.Sprintf("/project/%s/token", V1ProjectPath(projectSlug))
+	if err := c.GetV1(ctx, endpoint, &tokens); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for i := range tokens {
+		if tokens[i].ID == id {
+			return &tokens[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func mapProjectAPITokenToModel(token *ProjectAPITokenAPI, data *ProjectAPITokenResourceModel) {
+	data.ID = types.StringValue(token.ID)
+	data.Label = types.StringValue(token.Label)
+	data.Scope = types.StringValue(token.Scope)
+	data.CreatedAt = types.StringValue(token.Time)
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseProjectAPITokenImportID(t *testing.T) {
+	tests := []struct {
+		importID string
+		slug     string
+		id       string
+		ok       bool
+	}{
+		{importID: "gh/acme/api:abc123", slug: "gh/acme/api", id: "abc123", ok: true},
+		{importID: "circleci/org/project:abc:123", slug: "circleci/org/project", id: "abc:123", ok: true},
+		{importID: "gh/acme/api", ok: false},
+		{importID: ":abc123", ok: false},
+		{importID: "gh/acme/api:", ok: false},
+	}
+
+	for _, tt := range tests {
+		slug, id, ok := parseProjectAPITokenImportID(tt.importID)
+		if slug != tt.slug || id != tt.id || ok != tt.ok {
+			t.Errorf("parseProjectAPITokenImportID(%q) = %q, %q, %v, want %q, %q, %v", tt.importID, slug, id, ok, tt.slug, tt.id, tt.ok)
+		}
+	}
+}
+
+func TestFindProjectAPIToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1.1/project/github/acme/api/token":
+			fmt.Fprint(w, `[{"id":"tok-1","label":"status badge","scope":"status","time":"2024-01-02T03:04:05Z"}]`)
+		case "/api/v1.1/project/github/acme/broken/token":
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"message":"Internal error"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"message":"Project not found"}`)
+		}
+	}))
+	defer server.Close()
+
+	client := &CircleCIClient{BaseURL: server.URL + "/api/v2", HTTPClient: server.Client()}
+
+	token, err := findProjectAPIToken(context.Background(), client, "gh/acme/api", "tok-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token == nil || token.Label != "status badge" {
+		t.Errorf("findProjectAPIToken() = %+v, want token tok-1", token)
+	}
+
+	// A revoked token is gone from the list, so the resource is removed.
+	token, err = findProjectAPIToken(context.Background(), client, "gh/acme/api", "tok-2")
+	if err != nil || token != nil {
+		t.Errorf("findProjectAPIToken() = %+v, %v for a revoked token, want nil, nil", token, err)
+	}
+
+	// So is a token of a deleted project.
+	token, err = findProjectAPIToken(context.Background(), client, "gh/acme/gone", "tok-1")
+	if err != nil || token != nil {
+		t.Errorf("findProjectAPIToken() = %+v, %v for a deleted project, want nil, nil", token, err)
+	}
+
+	if _, err := findProjectAPIToken(context.Background(), client, "gh/acme/broken", "tok-1"); err == nil {
+		t.Error("expected an error when the API fails")
+	}
+}
@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/ssh"
+)
+
+var _ resource.Resource = &ProjectSSHKeyResource{}
+var _ resource.ResourceWithImportState = &ProjectSSHKeyResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectSSHKeyResource{}
+
+func NewProjectSSHKeyResource() resource.Resource {
+	return &ProjectSSHKeyResource{}
+}
+
+// ProjectSSHKeyResource manages the additional SSH keys of a project, the
+// keys jobs load with the add_ssh_keys step. They are only exposed by the
+// CircleCI API v1.1.
+type ProjectSSHKeyResource struct {
+	client *CircleCIClient
+}
+
+type ProjectSSHKeyResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	ProjectSlug       types.String `tfsdk:"project_slug"`
+	Hostname          types.String `tfsdk:"hostname"`
+	PrivateKey        types.String `tfsdk:"private_key"`
+	PublicKey         types.String `tfsdk:"public_key"`
+	Fingerprint       types.String `tfsdk:"fingerprint"`
+	FingerprintSHA256 types.String `tfsdk:"fingerprint_sha256"`
+}
+
+// CircleCI API v1.1 models for project SSH keys
+type ProjectSSHKeyRequest struct {
+	Hostname   string `json:"hostname"`
+	PrivateKey string `json:"private_key,omitempty"`
+	// Fingerprint identifies the key to delete.
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
+
+type ProjectSSHKeyAPI struct {
+	Hostname    string `json:"hostname"`
+	PublicKey   string `json:"public_key"`
+	Fingerprint string `json:"fingerprint"`
+}
+
+type ProjectSettingsV1 struct {
+	SSHKeys []ProjectSSHKeyAPI `json:"ssh_keys"`
+}
+
+func (r *ProjectSSHKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_ssh_key"
+}
+
+func (r *ProjectSSHKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Project SSH Key resource. Additional SSH keys are loaded into jobs with the `add_ssh_keys` step, for example to reach deployment hosts.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier of the SSH key (format: project_slug:hostname:fingerprint).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_slug": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The project slug in the form 'vcs-slug/org-name/repo-name'.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The hostname the key is used for. When empty the key is used for every host.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_key": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The unencrypted private key in PEM or OpenSSH format.",
+				PlanModifiers: []planmodifier.String{
+					// Imported keys have no private key in state; ModifyPlan
+					// replaces them only if the configured key is different.
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the private key forces a new key to be uploaded.",
+						"Changing the private key forces a new key to be uploaded.",
+					),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The public key, in OpenSSH authorized_keys format.",
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The MD5 fingerprint of the key, as shown by CircleCI (for example 'c9:0b:1c:...').",
+			},
+			"fingerprint_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA256 fingerprint of the key (for example 'SHA256:...').",
+			},
+		},
+	}
+}
+
+func (r *ProjectSSHKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProjectSSHKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectSSHKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := setSSHKeyFingerprints(&data); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("private_key"), "Invalid Private Key", err.Error())
+		return
+	}
+
+	createReq := ProjectSSHKeyRequest{
+		Hostname:   data.Hostname.ValueString(),
+		PrivateKey: data.PrivateKey.ValueString(),
+	}
+
+	endpoint := fmt.Sprintf("/project/%s/ssh-key", V1ProjectPath(data.ProjectSlug.ValueString()))
+	if err := r.client.PostV1(ctx, endpoint, createReq, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project SSH key, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(projectSSHKeyID(data.ProjectSlug.ValueString(), data.Hostname.ValueString(), data.Fingerprint.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectSSHKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectSSHKeyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The v1.1 API has no endpoint for a single key; look it up in the
+	// project settings.
+	var settings ProjectSettingsV1
+	endpoint := fmt.Sprintf("/project/%s/settings", V1ProjectPath(data.ProjectSlug.ValueString()))
+	if err := r.client.GetV1(ctx, endpoint, &settings); err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project settings, got error: %s", err))
+		return
+	}
+
+	var key *ProjectSSHKeyAPI
+	for i := range settings.SSHKeys {
+		if settings.SSHKeys[i].Fingerprint == data.Fingerprint.ValueString() && settings.SSHKeys[i].Hostname == data.Hostname.ValueString() {
+			key = &settings.SSHKeys[i]
+			break
+		}
+	}
+
+	if key == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Imported keys only have the fingerprint until the private key is
+	// adopted from the configuration.
+	if data.PublicKey.IsNull() && key.PublicKey != "" {
+		data.PublicKey = types.StringValue(key.PublicKey)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectSSHKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute forces a replacement except the private key of an
+	// imported key, whose fingerprint ModifyPlan has already checked.
+	var data ProjectSSHKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := setSSHKeyFingerprints(&data); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("private_key"), "Invalid Private Key", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectSSHKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectSSHKeyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteReq := ProjectSSHKeyRequest{
+		Hostname:    data.Hostname.ValueString(),
+		Fingerprint: data.Fingerprint.ValueString(),
+	}
+
+	endpoint := fmt.Sprintf("/project/%s/ssh-key", V1ProjectPath(data.ProjectSlug.ValueString()))
+	if err := r.client.DeleteV1(ctx, endpoint, deleteReq); err != nil {
+		if IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project SSH key, got error: %s", err))
+		return
+	}
+}
+
+func (r *ProjectSSHKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expected import format: "project_slug:hostname:fingerprint". The
+	// hostname may be empty and the MD5 fingerprint itself contains colons.
+	parts := strings.SplitN(req.ID, ":", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: project_slug:hostname:fingerprint. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_slug"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostname"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fingerprint"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+func (r *ProjectSSHKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ProjectSSHKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.PrivateKey.IsUnknown() {
+		return
+	}
+
+	// Fingerprints are computed locally so they show up in the plan.
+	if err := setSSHKeyFingerprints(&plan); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("private_key"), "Invalid Private Key", err.Error())
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	var state ProjectSSHKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An imported key only takes the configured private key over if it is
+	// the same key.
+	if state.PrivateKey.IsNull() && plan.Fingerprint.ValueString() != state.Fingerprint.ValueString() {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("private_key"))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// setSSHKeyFingerprints derives the public key and fingerprints of the
+// model's private key.
+func setSSHKeyFingerprints(data *ProjectSSHKeyResourceModel) error {
+	publicKey, md5, sha256, err := sshKeyFingerprints(data.PrivateKey.ValueString())
+	if err != nil {
+		return err
+	}
+
+	data.PublicKey = types.StringValue(publicKey)
+	data.Fingerprint = types.StringValue(md5)
+	data.FingerprintSHA256 = types.StringValue(sha256)
+	return nil
+}
+
+// sshKeyFingerprints returns the authorized_keys line and the MD5 and SHA256
+// fingerprints of a private key, formatted the way CircleCI displays them.
+func sshKeyFingerprints(privateKey string) (publicKey, md5, sha256 string, err error) {
+	signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+	if err != nil {
+		return "", "", "", fmt.Errorf("unable to parse private key: %w", err)
+	}
+
+	pub := signer.PublicKey()
+	publicKey = strings.TrimSpace(string(ssh.MarshalAuthorizedKey(pub)))
+	return publicKey, ssh.FingerprintLegacyMD5(pub), ssh.FingerprintSHA256(pub), nil
+}
+
+func projectSSHKeyID(projectSlug, hostname, fingerprint string) string {
+	return fmt.Sprintf("%s:%s:%s", projectSlug, hostname, fingerprint)
+}
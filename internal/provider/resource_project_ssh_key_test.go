@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"regexp"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+func TestSSHKeyFingerprints(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := ssh.MarshalPrivateKey(key, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publicKey, md5, sha256, err := sshKeyFingerprints(string(pem.EncodeToMemory(block)))
+	if err != nil {
+		t.Fatalf("sshKeyFingerprints() error = %v", err)
+	}
+
+	if !strings.HasPrefix(publicKey, "ssh-ed25519 ") {
+		t.Errorf("public key = %q, want an ssh-ed25519 authorized_keys line", publicKey)
+	}
+	if !regexp.MustCompile(`^([0-9a-f]{2}:){15}[0-9a-f]{2}$`).MatchString(md5) {
+		t.Errorf("md5 fingerprint = %q, want colon-separated hex", md5)
+	}
+	if !strings.HasPrefix(sha256, "SHA256:") {
+		t.Errorf("sha256 fingerprint = %q, want a SHA256: prefix", sha256)
+	}
+
+	if _, _, _, err := sshKeyFingerprints("not a key"); err == nil {
+		t.Error("expected an error for an invalid private key")
+	}
+}
@@ -45,6 +45,22 @@ type RunnerResourceModel struct {
 	State          types.String `tfsdk:"state"`
 }
 
+// RunnerAPI is the CircleCI API representation of a self-hosted runner.
+type RunnerAPI struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	ResourceClass  string `json:"resource_class"`
+	Platform       string `json:"platform"`
+	IP             string `json:"ip"`
+	Hostname       string `json:"hostname"`
+	Version        string `json:"version"`
+	FirstConnected string `json:"first_connected"`
+	LastConnected  string `json:"last_connected"`
+	LastUsed       string `json:"last_used"`
+	State          string `json:"state"`
+}
+
 func (r *RunnerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_runner"
 }
@@ -194,20 +210,7 @@ func (r *RunnerResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	var apiResponse struct {
-		ID             string `json:"id"`
-		Name           string `json:"name"`
-		Description    string `json:"description"`
-		ResourceClass  string `json:"resource_class"`
-		Platform       string `json:"platform"`
-		IP             string `json:"ip"`
-		Hostname       string `json:"hostname"`
-		Version        string `json:"version"`
-		FirstConnected string `json:"first_connected"`
-		LastConnected  string `json:"last_connected"`
-		LastUsed       string `json:"last_used"`
-		State          string `json:"state"`
-	}
+	var apiResponse RunnerAPI
 
 	if err := json.NewDecoder(httpResp.Body).Decode(&apiResponse); err != nil {
 		resp.Diagnostics.AddError(
@@ -218,18 +221,7 @@ func (r *RunnerResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Update the model with response data
-	data.ID = types.StringValue(apiResponse.ID)
-	data.Name = types.StringValue(apiResponse.Name)
-	data.Description = types.StringValue(apiResponse.Description)
-	data.ResourceClass = types.StringValue(apiResponse.ResourceClass)
-	data.Platform = types.StringValue(apiResponse.Platform)
-	data.IP = types.StringValue(apiResponse.IP)
-	data.Hostname = types.StringValue(apiResponse.Hostname)
-	data.Version = types.StringValue(apiResponse.Version)
-	data.FirstConnected = types.StringValue(apiResponse.FirstConnected)
-	data.LastConnected = types.StringValue(apiResponse.LastConnected)
-	data.LastUsed = types.StringValue(apiResponse.LastUsed)
-	data.State = types.StringValue(apiResponse.State)
+	mapRunnerToModel(&apiResponse, &data)
 
 	tflog.Trace(ctx, "created runner resource", map[string]interface{}{
 		"id": data.ID.ValueString(),
@@ -286,20 +278,7 @@ func (r *RunnerResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	var apiResponse struct {
-		ID             string `json:"id"`
-		Name           string `json:"name"`
-		Description    string `json:"description"`
-		ResourceClass  string `json:"resource_class"`
-		Platform       string `json:"platform"`
-		IP             string `json:"ip"`
-		Hostname       string `json:"hostname"`
-		Version        string `json:"version"`
-		FirstConnected string `json:"first_connected"`
-		LastConnected  string `json:"last_connected"`
-		LastUsed       string `json:"last_used"`
-		State          string `json:"state"`
-	}
+	var apiResponse RunnerAPI
 
 	if err := json.NewDecoder(httpResp.Body).Decode(&apiResponse); err != nil {
 		resp.Diagnostics.AddError(
@@ -310,18 +289,7 @@ func (r *RunnerResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// Update the model with response data
-	data.ID = types.StringValue(apiResponse.ID)
-	data.Name = types.StringValue(apiResponse.Name)
-	data.Description = types.StringValue(apiResponse.Description)
-	data.ResourceClass = types.StringValue(apiResponse.ResourceClass)
-	data.Platform = types.StringValue(apiResponse.Platform)
-	data.IP = types.StringValue(apiResponse.IP)
-	data.Hostname = types.StringValue(apiResponse.Hostname)
-	data.Version = types.StringValue(apiResponse.Version)
-	data.FirstConnected = types.StringValue(apiResponse.FirstConnected)
-	data.LastConnected = types.StringValue(apiResponse.LastConnected)
-	data.LastUsed = types.StringValue(apiResponse.LastUsed)
-	data.State = types.StringValue(apiResponse.State)
+	mapRunnerToModel(&apiResponse, &data)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -388,20 +356,7 @@ func (r *RunnerResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	var apiResponse struct {
-		ID             string `json:"id"`
-		Name           string `json:"name"`
-		Description    string `json:"description"`
-		ResourceClass  string `json:"resource_class"`
-		Platform       string `json:"platform"`
-		IP             string `json:"ip"`
-		Hostname       string `json:"hostname"`
-		Version        string `json:"version"`
-		FirstConnected string `json:"first_connected"`
-		LastConnected  string `json:"last_connected"`
-		LastUsed       string `json:"last_used"`
-		State          string `json:"state"`
-	}
+	var apiResponse RunnerAPI
 
 	if err := json.NewDecoder(httpResp.Body).Decode(&apiResponse); err != nil {
 		resp.Diagnostics.AddError(
@@ -412,18 +367,7 @@ func (r *RunnerResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Update the model with response data
-	data.ID = types.StringValue(apiResponse.ID)
-	data.Name = types.StringValue(apiResponse.Name)
-	data.Description = types.StringValue(apiResponse.Description)
-	data.ResourceClass = types.StringValue(apiResponse.ResourceClass)
-	data.Platform = types.StringValue(apiResponse.Platform)
-	data.IP = types.StringValue(apiResponse.IP)
-	data.Hostname = types.StringValue(apiResponse.Hostname)
-	data.Version = types.StringValue(apiResponse.Version)
-	data.FirstConnected = types.StringValue(apiResponse.FirstConnected)
-	data.LastConnected = types.StringValue(apiResponse.LastConnected)
-	data.LastUsed = types.StringValue(apiResponse.LastUsed)
-	data.State = types.StringValue(apiResponse.State)
+	mapRunnerToModel(&apiResponse, &data)
 
 	tflog.Trace(ctx, "updated runner resource", map[string]interface{}{
 		"id": data.ID.ValueString(),
@@ -482,3 +426,20 @@ func (r *RunnerResource) Delete(ctx context.Context, req resource.DeleteRequest,
 func (r *RunnerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// mapRunnerToModel copies an API runner into the Terraform model. It is shared
+// by the runner resource and the runner data sources.
+func mapRunnerToModel(runner *RunnerAPI, data *RunnerResourceModel) {
+	data.ID = types.StringValue(runner.ID)
+	data.Name = types.StringValue(runner.Name)
+	data.Description = types.StringValue(runner.Description)
+	data.ResourceClass = types.StringValue(runner.ResourceClass)
+	data.Platform = types.StringValue(runner.Platform)
+	data.IP = types.StringValue(runner.IP)
+	data.Hostname = types.StringValue(runner.Hostname)
+	data.Version = types.StringValue(runner.Version)
+	data.FirstConnected = types.StringValue(runner.FirstConnected)
+	data.LastConnected = types.StringValue(runner.LastConnected)
+	data.LastUsed = types.StringValue(runner.LastUsed)
+	data.State = types.StringValue(runner.State)
+}
@@ -6,12 +6,15 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -19,6 +22,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &RunnerTokenResource{}
 var _ resource.ResourceWithImportState = &RunnerTokenResource{}
+var _ resource.ResourceWithModifyPlan = &RunnerTokenResource{}
 
 func NewRunnerTokenResource() resource.Resource {
 	return &RunnerTokenResource{}
@@ -31,11 +35,14 @@ type RunnerTokenResource struct {
 
 // RunnerTokenResourceModel describes the resource data model.
 type RunnerTokenResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	ResourceClass types.String `tfsdk:"resource_class"`
-	Nickname      types.String `tfsdk:"nickname"`
-	Token         types.String `tfsdk:"token"`
-	CreatedAt     types.String `tfsdk:"created_at"`
+	ID               types.String `tfsdk:"id"`
+	ResourceClass    types.String `tfsdk:"resource_class"`
+	Nickname         types.String `tfsdk:"nickname"`
+	Token            types.String `tfsdk:"token"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	RotationTriggers types.Map    `tfsdk:"rotation_triggers"`
+	RotateAfter      types.String `tfsdk:"rotate_after"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
 }
 
 func (r *RunnerTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -44,7 +51,9 @@ func (r *RunnerTokenResource) Metadata(ctx context.Context, req resource.Metadat
 
 func (r *RunnerTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a CircleCI runner authentication token.",
+		MarkdownDescription: "Manages a CircleCI runner authentication token. " +
+			"Use `rotation_triggers` or `rotate_after` together with `lifecycle { create_before_destroy = true }` " +
+			"to rotate the token without a gap in job pickup.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -79,6 +88,28 @@ func (r *RunnerTokenResource) Schema(ctx context.Context, req resource.SchemaReq
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "The date and time the token was created.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotation_triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary map of values that, when changed, will force the token to be rotated.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotate_after": schema.StringAttribute{
+				MarkdownDescription: "Duration after which the token is rotated (e.g. `720h`). The token is replaced on the first plan after it expires.",
+				Optional:            true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "The date and time (RFC 3339) after which the token will be rotated. Only set when `rotate_after` is configured.",
+				Computed:            true,
 			},
 		},
 	}
@@ -185,6 +216,12 @@ func (r *RunnerTokenResource) Create(ctx context.Context, req resource.CreateReq
 	data.Token = types.StringValue(apiResponse.Token)
 	data.CreatedAt = types.StringValue(apiResponse.CreatedAt)
 
+	// expires_at is normally computed at plan time; fall back to now if the
+	// plan could not determine it.
+	if data.ExpiresAt.IsUnknown() {
+		data.ExpiresAt = runnerTokenExpiry(data.RotateAfter, time.Now())
+	}
+
 	tflog.Trace(ctx, "created runner token resource", map[string]interface{}{
 		"id": data.ID.ValueString(),
 	})
@@ -268,11 +305,23 @@ func (r *RunnerTokenResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (r *RunnerTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Runner tokens are immutable - any changes require replacement
-	resp.Diagnostics.AddError(
-		"Runner token cannot be updated",
-		"Runner tokens are immutable. Any changes require creating a new token.",
-	)
+	var data RunnerTokenResourceModel
+	var state RunnerTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Runner tokens are immutable on the API side; only rotate_after can change
+	// in place, which just moves the computed expiry.
+	data.ID = state.ID
+	data.Token = state.Token
+	data.CreatedAt = state.CreatedAt
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *RunnerTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -324,3 +373,74 @@ func (r *RunnerTokenResource) Delete(ctx context.Context, req resource.DeleteReq
 func (r *RunnerTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r *RunnerTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan RunnerTokenResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	now := time.Now()
+
+	// On create the expiry is anchored to the plan time.
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), runnerTokenExpiry(plan.RotateAfter, now))...)
+		return
+	}
+
+	var state RunnerTokenResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expiresAt := state.ExpiresAt
+	if !plan.RotateAfter.Equal(state.RotateAfter) {
+		// rotate_after changed in place: re-anchor the expiry on the creation time.
+		createdAt, err := time.Parse(time.RFC3339, state.CreatedAt.ValueString())
+		if err != nil {
+			createdAt = now
+		}
+		expiresAt = runnerTokenExpiry(plan.RotateAfter, createdAt)
+	}
+
+	if !expiresAt.IsNull() && !expiresAt.IsUnknown() {
+		expiry, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+		if err == nil && !now.Before(expiry) {
+			tflog.Debug(ctx, "runner token expired, planning rotation", map[string]interface{}{
+				"id":         state.ID.ValueString(),
+				"expires_at": expiresAt.ValueString(),
+			})
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), types.StringUnknown())...)
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires_at"))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), expiresAt)...)
+}
+
+// runnerTokenExpiry returns the expiry for a token created at from, or null if
+// rotate_after is not configured.
+func runnerTokenExpiry(rotateAfter types.String, from time.Time) types.String {
+	if rotateAfter.IsUnknown() {
+		return types.StringUnknown()
+	}
+
+	if rotateAfter.IsNull() {
+		return types.StringNull()
+	}
+
+	d, err := time.ParseDuration(rotateAfter.ValueString())
+	if err != nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(from.Add(d).UTC().Format(time.RFC3339))
+}
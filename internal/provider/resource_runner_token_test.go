@@ -59,6 +59,40 @@ func TestAccRunnerTokenResource_minimal(t *testing.T) {
 	})
 }
 
+func TestAccRunnerTokenResource_rotation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunnerTokenResourceConfigRotation("my-org/test-runner-class", "v1", "720h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("circleci_runner_token.test", "rotation_triggers.version", "v1"),
+					resource.TestCheckResourceAttr("circleci_runner_token.test", "rotate_after", "720h"),
+					resource.TestCheckResourceAttrSet("circleci_runner_token.test", "expires_at"),
+					resource.TestCheckResourceAttrSet("circleci_runner_token.test", "token"),
+				),
+			},
+			// Changing rotate_after only moves the expiry
+			{
+				Config: testAccRunnerTokenResourceConfigRotation("my-org/test-runner-class", "v1", "1440h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("circleci_runner_token.test", "rotate_after", "1440h"),
+					resource.TestCheckResourceAttrSet("circleci_runner_token.test", "expires_at"),
+				),
+			},
+			// Changing a trigger rotates the token
+			{
+				Config: testAccRunnerTokenResourceConfigRotation("my-org/test-runner-class", "v2", "1440h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("circleci_runner_token.test", "rotation_triggers.version", "v2"),
+					resource.TestCheckResourceAttrSet("circleci_runner_token.test", "token"),
+				),
+			},
+		},
+	})
+}
+
 func testAccRunnerTokenResourceConfig(resourceClass, nickname string) string {
 	return `
 resource "circleci_runner_token" "test" {
@@ -75,3 +109,21 @@ resource "circleci_runner_token" "test" {
 }
 `
 }
+
+func testAccRunnerTokenResourceConfigRotation(resourceClass, version, rotateAfter string) string {
+	return `
+resource "circleci_runner_token" "test" {
+  resource_class = "` + resourceClass + `"
+  nickname       = "rotating-token"
+  rotate_after   = "` + rotateAfter + `"
+
+  rotation_triggers = {
+    version = "` + version + `"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`
+}
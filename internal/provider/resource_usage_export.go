@@ -6,17 +6,44 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &UsageExportResource{}
 var _ resource.ResourceWithImportState = &UsageExportResource{}
+var _ resource.ResourceWithModifyPlan = &UsageExportResource{}
+var _ resource.ResourceWithValidateConfig = &UsageExportResource{}
+
+// usageExportPollInterval is how often Create polls a running export. It is a
+// variable so that tests can shorten it.
+var usageExportPollInterval = 10 * time.Second
+
+const (
+	// usageExportDefaultCreateTimeout bounds how long Create waits for the
+	// export job when no timeouts block is configured.
+	usageExportDefaultCreateTimeout = 30 * time.Minute
+
+	// CircleCI rejects exports spanning more than 32 days or starting more
+	// than a year ago.
+	usageExportMaxRange = 32 * 24 * time.Hour
+	usageExportLookback = 365 * 24 * time.Hour
+)
+
+// usageExportWindows are the rolling windows an export can be defined by
+// instead of fixed dates.
+var usageExportWindows = []string{"last_month", "previous_7_days"}
 
 func NewUsageExportResource() resource.Resource {
 	return &UsageExportResource{}
@@ -27,26 +54,30 @@ type UsageExportResource struct {
 }
 
 type UsageExportResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	OrgID       types.String `tfsdk:"org_id"`
-	Start       types.String `tfsdk:"start"`
-	End         types.String `tfsdk:"end"`
-	Status      types.String `tfsdk:"status"`
-	DownloadURL types.String `tfsdk:"download_url"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	ExpiresAt   types.String `tfsdk:"expires_at"`
+	ID            types.String   `tfsdk:"id"`
+	OrgID         types.String   `tfsdk:"org_id"`
+	Window        types.String   `tfsdk:"window"`
+	Start         types.String   `tfsdk:"start"`
+	End           types.String   `tfsdk:"end"`
+	Status        types.String   `tfsdk:"status"`
+	DownloadURLs  types.List     `tfsdk:"download_urls"`
+	FailureReason types.String   `tfsdk:"failure_reason"`
+	CreatedAt     types.String   `tfsdk:"created_at"`
+	ExpiresAt     types.String   `tfsdk:"expires_at"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 // CircleCI API models for usage exports
 type UsageExportAPI struct {
-	ID          string `json:"id"`
-	OrgID       string `json:"org_id"`
-	Start       string `json:"start"`
-	End         string `json:"end"`
-	Status      string `json:"status"`
-	DownloadURL string `json:"download_url,omitempty"`
-	CreatedAt   string `json:"created_at"`
-	ExpiresAt   string `json:"expires_at,omitempty"`
+	ID            string   `json:"id"`
+	OrgID         string   `json:"org_id"`
+	Start         string   `json:"start"`
+	End           string   `json:"end"`
+	Status        string   `json:"status"`
+	DownloadURLs  []string `json:"download_urls,omitempty"`
+	FailureReason string   `json:"failure_reason,omitempty"`
+	CreatedAt     string   `json:"created_at"`
+	ExpiresAt     string   `json:"expires_at,omitempty"`
 }
 
 type UsageExportRequest struct {
@@ -60,7 +91,7 @@ func (r *UsageExportResource) Metadata(ctx context.Context, req resource.Metadat
 
 func (r *UsageExportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "CircleCI Usage Export resource. This resource allows you to export organization usage data for a specified time range.",
+		MarkdownDescription: "CircleCI Usage Export resource. This resource allows you to export organization usage data for a specified time range, either fixed or rolling.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -77,27 +108,53 @@ func (r *UsageExportResource) Schema(ctx context.Context, req resource.SchemaReq
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"start": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The start date for the usage export (ISO 8601 format).",
+			"window": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A rolling time range computed at plan time instead of fixed `start` and `end` dates. One of 'last_month' (the previous calendar month) or 'previous_7_days' (the seven days before today, UTC). When the window rolls forward the export is replaced.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(usageExportWindows...),
+					stringvalidator.ConflictsWith(path.MatchRoot("start"), path.MatchRoot("end")),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"start": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The start date for the usage export (RFC 3339 format). Computed when `window` is set.",
+				Validators: []validator.String{
+					rfc3339Validator{},
+					stringvalidator.AlsoRequires(path.MatchRoot("end")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
 			"end": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The end date for the usage export (ISO 8601 format).",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The end date for the usage export (RFC 3339 format). Computed when `window` is set.",
+				Validators: []validator.String{
+					rfc3339Validator{},
+					stringvalidator.AlsoRequires(path.MatchRoot("start")),
+				},
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"status": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The status of the usage export (pending, processing, completed, failed).",
 			},
-			"download_url": schema.StringAttribute{
+			"download_urls": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The download URLs of the gzipped CSV files of the completed export.",
+			},
+			"failure_reason": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "The download URL for the completed export (available when status is 'completed').",
+				MarkdownDescription: "Why the export failed (available when status is 'failed').",
 			},
 			"created_at": schema.StringAttribute{
 				Computed:            true,
@@ -108,6 +165,12 @@ func (r *UsageExportResource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "The date and time the export download will expire.",
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
 	}
 }
 
@@ -136,13 +199,19 @@ func (r *UsageExportResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, usageExportDefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	orgID := data.OrgID.ValueString()
 	exportRequest := UsageExportRequest{
 		Start: data.Start.ValueString(),
 		End:   data.End.ValueString(),
 	}
 
-	endpoint := fmt.Sprintf("/v2/organization/%s/usage-export", orgID)
+	endpoint := fmt.Sprintf("/organization/%s/usage-export", orgID)
 	httpResp, err := r.client.MakeRequest(ctx, "POST", endpoint, exportRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create usage export", err.Error())
@@ -164,9 +233,25 @@ func (r *UsageExportResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	r.mapExportToModel(&export, &data)
+	// The export job runs asynchronously; wait for it so the download URLs
+	// are available to the rest of the configuration.
+	waitErr := r.waitForExport(ctx, orgID, &export, createTimeout)
 
+	resp.Diagnostics.Append(r.mapExportToModel(ctx, &export, &data)...)
+	// Save the export even on failure so that it is tainted and replaced.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if waitErr != nil {
+		resp.Diagnostics.AddError("Failed to wait for usage export", waitErr.Error())
+		return
+	}
+
+	if export.Status == "failed" {
+		resp.Diagnostics.AddError(
+			"Usage export failed",
+			fmt.Sprintf("Usage export %s failed: %s", export.ID, export.FailureReason),
+		)
+	}
 }
 
 func (r *UsageExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -180,7 +265,7 @@ func (r *UsageExportResource) Read(ctx context.Context, req resource.ReadRequest
 	orgID := data.OrgID.ValueString()
 	exportID := data.ID.ValueString()
 
-	endpoint := fmt.Sprintf("/v2/organization/%s/usage-export/%s", orgID, exportID)
+	endpoint := fmt.Sprintf("/organization/%s/usage-export/%s", orgID, exportID)
 	httpResp, err := r.client.MakeRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to get usage export", err.Error())
@@ -207,15 +292,28 @@ func (r *UsageExportResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	r.mapExportToModel(&export, &data)
+	resp.Diagnostics.Append(r.mapExportToModel(ctx, &export, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *UsageExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Usage exports are immutable once created
-	// Just refresh the current state
-	r.Read(ctx, resource.ReadRequest{State: req.State}, &resource.ReadResponse{State: resp.State, Diagnostics: resp.Diagnostics})
+	// Usage exports are immutable once created; every other change forces a
+	// replacement, so only the timeouts can change in place.
+	var plan, data UsageExportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *UsageExportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -229,7 +327,7 @@ func (r *UsageExportResource) Delete(ctx context.Context, req resource.DeleteReq
 	orgID := data.OrgID.ValueString()
 	exportID := data.ID.ValueString()
 
-	endpoint := fmt.Sprintf("/v2/organization/%s/usage-export/%s", orgID, exportID)
+	endpoint := fmt.Sprintf("/organization/%s/usage-export/%s", orgID, exportID)
 	httpResp, err := r.client.MakeRequest(ctx, "DELETE", endpoint, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to delete usage export", err.Error())
@@ -265,18 +363,194 @@ func (r *UsageExportResource) ImportState(ctx context.Context, req resource.Impo
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), exportID)...)
 }
 
-func (r *UsageExportResource) mapExportToModel(export *UsageExportAPI, data *UsageExportResourceModel) {
+func (r *UsageExportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UsageExportResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Window.IsNull() && data.Start.IsNull() && data.End.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("window"),
+			"Missing Time Range",
+			"Either window or both start and end must be set.",
+		)
+		return
+	}
+
+	if data.Start.IsNull() || data.Start.IsUnknown() || data.End.IsNull() || data.End.IsUnknown() {
+		return
+	}
+
+	start, startErr := time.Parse(time.RFC3339, data.Start.ValueString())
+	end, endErr := time.Parse(time.RFC3339, data.End.ValueString())
+	if startErr != nil || endErr != nil {
+		// Reported by the attribute validators.
+		return
+	}
+
+	if err := validateUsageExportRange(start, end); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("end"), "Invalid Time Range", err.Error())
+	}
+}
+
+func (r *UsageExportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan UsageExportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *UsageExportResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &UsageExportResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	now := time.Now()
+
+	if !plan.Window.IsNull() && !plan.Window.IsUnknown() {
+		start, end := usageExportWindowRange(plan.Window.ValueString(), now)
+
+		// Keep the existing export while the window has not rolled forward.
+		if state != nil && sameTimestamp(state.Start, start) && sameTimestamp(state.End, end) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("start"), state.Start)...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("end"), state.End)...)
+			return
+		}
+
+		plan.Start = types.StringValue(start.Format(time.RFC3339))
+		plan.End = types.StringValue(end.Format(time.RFC3339))
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("start"), plan.Start)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("end"), plan.End)...)
+
+		if state != nil {
+			tflog.Debug(ctx, "usage export window rolled forward, planning replacement", map[string]interface{}{
+				"id":     state.ID.ValueString(),
+				"window": plan.Window.ValueString(),
+			})
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("start"), path.Root("end"))
+		}
+	}
+
+	// The lookback limit depends on the current time, so it is only checked
+	// when a new export is about to be created.
+	if state != nil && state.Start.Equal(plan.Start) {
+		return
+	}
+	if plan.Start.IsNull() || plan.Start.IsUnknown() {
+		return
+	}
+
+	start, err := time.Parse(time.RFC3339, plan.Start.ValueString())
+	if err == nil && start.Before(now.Add(-usageExportLookback)) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("start"),
+			"Invalid Time Range",
+			fmt.Sprintf("CircleCI only keeps usage data for one year; start %s is before %s.", plan.Start.ValueString(), now.Add(-usageExportLookback).UTC().Format(time.RFC3339)),
+		)
+	}
+}
+
+// usageExportWindowRange returns the time range a rolling window covers at
+// now. Both windows are aligned on UTC days so that plans within the same
+// day agree.
+func usageExportWindowRange(window string, now time.Time) (time.Time, time.Time) {
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch window {
+	case "last_month":
+		end := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return end.AddDate(0, -1, 0), end.Add(-time.Second)
+	default: // "previous_7_days"
+		return today.AddDate(0, 0, -7), today.Add(-time.Second)
+	}
+}
+
+// validateUsageExportRange checks the limits CircleCI puts on the range of an
+// export that do not depend on the current time.
+func validateUsageExportRange(start, end time.Time) error {
+	if !end.After(start) {
+		return fmt.Errorf("end (%s) must be after start (%s)", end.Format(time.RFC3339), start.Format(time.RFC3339))
+	}
+
+	if end.Sub(start) > usageExportMaxRange {
+		return fmt.Errorf("usage exports can span at most 32 days, got %s", end.Sub(start))
+	}
+
+	return nil
+}
+
+// sameTimestamp reports whether value holds an RFC 3339 timestamp equal to t,
+// regardless of how the API formatted it.
+func sameTimestamp(value types.String, t time.Time) bool {
+	if value.IsNull() || value.IsUnknown() {
+		return false
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value.ValueString())
+	return err == nil && parsed.Equal(t)
+}
+
+// keepTimestamp returns current when it holds the same instant as the API
+// value, so that the planned formatting survives the API's own, such as
+// fractional seconds.
+func keepTimestamp(current types.String, apiValue string) types.String {
+	if t, err := time.Parse(time.RFC3339, apiValue); err == nil && sameTimestamp(current, t) {
+		return current
+	}
+	return types.StringValue(apiValue)
+}
+
+// waitForExport polls the export until it completes or fails, updating
+// export in place.
+func (r *UsageExportResource) waitForExport(ctx context.Context, orgID string, export *UsageExportAPI, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(usageExportPollInterval)
+	defer ticker.Stop()
+
+	for export.Status != "completed" && export.Status != "failed" {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("usage export %s still %q after %s", export.ID, export.Status, timeout)
+		case <-ticker.C:
+		}
+
+		polled, err := getUsageExport(ctx, r.client, orgID, export.ID)
+		if err != nil {
+			return err
+		}
+		*export = polled
+	}
+
+	return nil
+}
+
+func (r *UsageExportResource) mapExportToModel(ctx context.Context, export *UsageExportAPI, data *UsageExportResourceModel) diag.Diagnostics {
 	data.ID = types.StringValue(export.ID)
 	data.OrgID = types.StringValue(export.OrgID)
-	data.Start = types.StringValue(export.Start)
-	data.End = types.StringValue(export.End)
+	data.Start = keepTimestamp(data.Start, export.Start)
+	data.End = keepTimestamp(data.End, export.End)
 	data.Status = types.StringValue(export.Status)
 	data.CreatedAt = types.StringValue(export.CreatedAt)
 
-	if export.DownloadURL != "" {
-		data.DownloadURL = types.StringValue(export.DownloadURL)
+	if export.FailureReason != "" {
+		data.FailureReason = types.StringValue(export.FailureReason)
 	} else {
-		data.DownloadURL = types.StringNull()
+		data.FailureReason = types.StringNull()
 	}
 
 	if export.ExpiresAt != "" {
@@ -284,4 +558,9 @@ func (r *UsageExportResource) mapExportToModel(export *UsageExportAPI, data *Usa
 	} else {
 		data.ExpiresAt = types.StringNull()
 	}
+
+	downloadURLs, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(export.DownloadURLs))
+	data.DownloadURLs = downloadURLs
+
+	return diags
 }
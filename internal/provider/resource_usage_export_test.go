@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUsageExportWindowRange(t *testing.T) {
+	now := time.Date(2024, time.March, 15, 13, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		window    string
+		wantStart string
+		wantEnd   string
+	}{
+		{window: "last_month", wantStart: "2024-02-01T00:00:00Z", wantEnd: "2024-02-29T23:59:59Z"},
+		{window: "previous_7_days", wantStart: "2024-03-08T00:00:00Z", wantEnd: "2024-03-14T23:59:59Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.window, func(t *testing.T) {
+			start, end := usageExportWindowRange(tt.window, now)
+			if got := start.Format(time.RFC3339); got != tt.wantStart {
+				t.Errorf("start = %s, want %s", got, tt.wantStart)
+			}
+			if got := end.Format(time.RFC3339); got != tt.wantEnd {
+				t.Errorf("end = %s, want %s", got, tt.wantEnd)
+			}
+			if err := validateUsageExportRange(start, end); err != nil {
+				t.Errorf("window range rejected: %v", err)
+			}
+		})
+	}
+
+	// January rolls back into the previous year.
+	start, _ := usageExportWindowRange("last_month", time.Date(2024, time.January, 3, 0, 0, 0, 0, time.UTC))
+	if got := start.Format(time.RFC3339); got != "2023-12-01T00:00:00Z" {
+		t.Errorf("start = %s, want 2023-12-01T00:00:00Z", got)
+	}
+}
+
+func TestValidateUsageExportRange(t *testing.T) {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	if err := validateUsageExportRange(start, start.AddDate(0, 0, 32)); err != nil {
+		t.Errorf("32 days rejected: %v", err)
+	}
+	if err := validateUsageExportRange(start, start.AddDate(0, 0, 33)); err == nil {
+		t.Error("expected an error for a range over 32 days")
+	}
+	if err := validateUsageExportRange(start, start); err == nil {
+		t.Error("expected an error for an empty range")
+	}
+}
+
+func TestWaitForExport(t *testing.T) {
+	defer func(interval time.Duration) { usageExportPollInterval = interval }(usageExportPollInterval)
+	usageExportPollInterval = time.Millisecond
+
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/organization/org-1/usage-export/exp-1" {
+			http.NotFound(w, r)
+			return
+		}
+		polls++
+		status := "processing"
+		if polls > 1 {
+			status = "completed"
+		}
+		fmt.Fprintf(w, `{"id":"exp-1","org_id":"org-1","status":%q}`, status)
+	}))
+	defer server.Close()
+
+	r := &UsageExportResource{client: &CircleCIClient{BaseURL: server.URL + "/api/v2", HTTPClient: server.Client()}}
+	export := &UsageExportAPI{ID: "exp-1", Status: "created"}
+
+	if err := r.waitForExport(context.Background(), "org-1", export, time.Minute); err != nil {
+		t.Fatalf("waitForExport() error = %v", err)
+	}
+	if export.Status != "completed" || polls != 2 {
+		t.Errorf("status = %q after %d polls, want completed after 2", export.Status, polls)
+	}
+}
+
+func TestKeepTimestamp(t *testing.T) {
+	planned := types.StringValue("2024-03-01T00:00:00Z")
+
+	if got := keepTimestamp(planned, "2024-03-01T00:00:00.000Z"); !got.Equal(planned) {
+		t.Errorf("keepTimestamp() = %s, want the planned value for the same instant", got)
+	}
+	if got := keepTimestamp(planned, "2024-03-02T00:00:00.000Z"); got.ValueString() != "2024-03-02T00:00:00.000Z" {
+		t.Errorf("keepTimestamp() = %s, want the API value for a different instant", got)
+	}
+	if got := keepTimestamp(types.StringNull(), "2024-03-01T00:00:00Z"); got.ValueString() != "2024-03-01T00:00:00Z" {
+		t.Errorf("keepTimestamp() = %s, want the API value when nothing is known", got)
+	}
+}
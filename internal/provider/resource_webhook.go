@@ -2,22 +2,33 @@ package provider
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 var _ resource.Resource = &WebhookResource{}
 var _ resource.ResourceWithImportState = &WebhookResource{}
+var _ resource.ResourceWithModifyPlan = &WebhookResource{}
+
+// webhookEvents lists the events a webhook can subscribe to.
+var webhookEvents = []string{"workflow-completed", "job-completed"}
 
 func NewWebhookResource() resource.Resource {
 	return &WebhookResource{}
@@ -28,20 +39,28 @@ type WebhookResource struct {
 }
 
 type WebhookResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	URL           types.String `tfsdk:"url"`
-	Events        types.List   `tfsdk:"events"`
-	SigningSecret types.String `tfsdk:"signing_secret"`
-	VerifyTLS     types.Bool   `tfsdk:"verify_tls"`
-	Scope         types.Object `tfsdk:"scope"`
-	CreatedAt     types.String `tfsdk:"created_at"`
-	UpdatedAt     types.String `tfsdk:"updated_at"`
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	URL                  types.String `tfsdk:"url"`
+	Events               types.List   `tfsdk:"events"`
+	SigningSecret        types.String `tfsdk:"signing_secret"`
+	SigningSecretVersion types.String `tfsdk:"signing_secret_version"`
+	VerifyTLS            types.Bool   `tfsdk:"verify_tls"`
+	Scope                types.Object `tfsdk:"scope"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+	UpdatedAt            types.String `tfsdk:"updated_at"`
 }
 
 type WebhookScope struct {
-	ID   types.String `tfsdk:"id"`
-	Type types.String `tfsdk:"type"`
+	ID          types.String `tfsdk:"id"`
+	Type        types.String `tfsdk:"type"`
+	ProjectSlug types.String `tfsdk:"project_slug"`
+}
+
+var webhookScopeAttrTypes = map[string]attr.Type{
+	"id":           types.StringType,
+	"type":         types.StringType,
+	"project_slug": types.StringType,
 }
 
 // CircleCI API models for webhooks
@@ -96,17 +115,30 @@ func (r *WebhookResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Required:            true,
 			},
 			"events": schema.ListAttribute{
-				MarkdownDescription: "The events that will trigger this webhook. Valid events include: workflow-completed, job-completed, etc.",
+				MarkdownDescription: "The events that will trigger this webhook. One or more of 'workflow-completed' and 'job-completed'.",
 				Required:            true,
 				ElementType:         types.StringType,
 				PlanModifiers: []planmodifier.List{
 					listplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(webhookEvents...)),
+				},
 			},
 			"signing_secret": schema.StringAttribute{
-				MarkdownDescription: "The secret used to sign webhook payloads.",
+				MarkdownDescription: "The secret used to sign webhook payloads. A random secret is generated when not set.",
 				Optional:            true,
+				Computed:            true,
 				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"signing_secret_version": schema.StringAttribute{
+				MarkdownDescription: "An arbitrary value that, when changed, rotates the generated signing secret in place. Has no effect when `signing_secret` is set.",
+				Optional:            true,
 			},
 			"verify_tls": schema.BoolAttribute{
 				MarkdownDescription: "Whether to verify TLS certificates when sending webhooks.",
@@ -115,16 +147,35 @@ func (r *WebhookResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Default:             booldefault.StaticBool(true),
 			},
 			"scope": schema.SingleNestedAttribute{
-				MarkdownDescription: "The scope of the webhook (organization or project).",
+				MarkdownDescription: "The scope of the webhook. Set either `id` and `type`, or `project_slug`.",
 				Required:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
 				Attributes: map[string]schema.Attribute{
 					"id": schema.StringAttribute{
-						MarkdownDescription: "The unique identifier of the scope.",
-						Required:            true,
+						MarkdownDescription: "The unique identifier of the scope. Resolved from `project_slug` when that is set.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("project_slug")),
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("type")),
+						},
 					},
 					"type": schema.StringAttribute{
-						MarkdownDescription: "The type of the scope ('project' or 'organization').",
-						Required:            true,
+						MarkdownDescription: "The type of the scope ('project' or 'organization'). Set to 'project' when `project_slug` is used.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"project_slug": schema.StringAttribute{
+						MarkdownDescription: "The slug of the project to scope the webhook to, in the form 'vcs-slug/org-name/repo-name'.",
+						Optional:            true,
 					},
 				},
 			},
@@ -165,35 +216,21 @@ func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	// Extract events list
-	var events []string
-	resp.Diagnostics.Append(data.Events.ElementsAs(ctx, &events, false)...)
-	if resp.Diagnostics.HasError() {
-		return
+	if data.SigningSecret.IsUnknown() {
+		secret, err := generateWebhookSecret()
+		if err != nil {
+			resp.Diagnostics.AddError("Signing Secret Error", fmt.Sprintf("Unable to generate webhook signing secret, got error: %s", err))
+			return
+		}
+		data.SigningSecret = types.StringValue(secret)
 	}
 
-	// Extract scope
-	var scope WebhookScope
-	resp.Diagnostics.Append(data.Scope.As(ctx, &scope, basetypes.ObjectAsOptions{})...)
+	createReq, diags := r.webhookRequest(ctx, &data)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	createReq := CreateWebhookRequest{
-		Name:      data.Name.ValueString(),
-		URL:       data.URL.ValueString(),
-		Events:    events,
-		VerifyTLS: data.VerifyTLS.ValueBool(),
-		Scope: ScopeObject{
-			ID:   scope.ID.ValueString(),
-			Type: scope.Type.ValueString(),
-		},
-	}
-
-	if !data.SigningSecret.IsNull() {
-		createReq.SigningSecret = data.SigningSecret.ValueString()
-	}
-
 	var webhook Webhook
 	if err := r.client.Post(ctx, "/webhook", createReq, &webhook); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook, got error: %s", err))
@@ -237,13 +274,22 @@ func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 	data.Events = eventsList
 
+	// The API only knows the scope id, so the project slug is kept from state.
+	projectSlug := types.StringNull()
+	if !data.Scope.IsNull() && !data.Scope.IsUnknown() {
+		var state WebhookScope
+		resp.Diagnostics.Append(data.Scope.As(ctx, &state, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		projectSlug = state.ProjectSlug
+	}
+
 	// Convert scope to types.Object
-	scopeObj, diags := types.ObjectValueFrom(ctx, map[string]attr.Type{
-		"id":   types.StringType,
-		"type": types.StringType,
-	}, WebhookScope{
-		ID:   types.StringValue(webhook.Scope.ID),
-		Type: types.StringValue(webhook.Scope.Type),
+	scopeObj, diags := types.ObjectValueFrom(ctx, webhookScopeAttrTypes, WebhookScope{
+		ID:          types.StringValue(webhook.Scope.ID),
+		Type:        types.StringValue(webhook.Scope.Type),
+		ProjectSlug: projectSlug,
 	})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -262,35 +308,22 @@ func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	// Extract events list
-	var events []string
-	resp.Diagnostics.Append(data.Events.ElementsAs(ctx, &events, false)...)
-	if resp.Diagnostics.HasError() {
-		return
+	// An unknown secret means signing_secret_version changed, so rotate it.
+	if data.SigningSecret.IsUnknown() {
+		secret, err := generateWebhookSecret()
+		if err != nil {
+			resp.Diagnostics.AddError("Signing Secret Error", fmt.Sprintf("Unable to generate webhook signing secret, got error: %s", err))
+			return
+		}
+		data.SigningSecret = types.StringValue(secret)
 	}
 
-	// Extract scope
-	var scope WebhookScope
-	resp.Diagnostics.Append(data.Scope.As(ctx, &scope, basetypes.ObjectAsOptions{})...)
+	updateReq, diags := r.webhookRequest(ctx, &data)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updateReq := CreateWebhookRequest{
-		Name:      data.Name.ValueString(),
-		URL:       data.URL.ValueString(),
-		Events:    events,
-		VerifyTLS: data.VerifyTLS.ValueBool(),
-		Scope: ScopeObject{
-			ID:   scope.ID.ValueString(),
-			Type: scope.Type.ValueString(),
-		},
-	}
-
-	if !data.SigningSecret.IsNull() {
-		updateReq.SigningSecret = data.SigningSecret.ValueString()
-	}
-
 	var webhook Webhook
 	if err := r.client.Put(ctx, fmt.Sprintf("/webhook/%s", data.ID.ValueString()), updateReq, &webhook); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update webhook, got error: %s", err))
@@ -320,3 +353,144 @@ func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest
 func (r *WebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r *WebhookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan WebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve the project slug at plan time so the scope id shows up in the
+	// plan. If the provider is not configured yet it is resolved on apply.
+	if !plan.Scope.IsNull() && !plan.Scope.IsUnknown() && r.client != nil {
+		var scope WebhookScope
+		resp.Diagnostics.Append(plan.Scope.As(ctx, &scope, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if scope.ID.IsUnknown() && !scope.ProjectSlug.IsNull() && !scope.ProjectSlug.IsUnknown() {
+			resp.Diagnostics.Append(r.resolveScope(ctx, &scope)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			scopeObj, diags := types.ObjectValueFrom(ctx, webhookScopeAttrTypes, scope)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("scope"), scopeObj)...)
+		}
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state WebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var configSecret types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("signing_secret"), &configSecret)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A new signing_secret_version rotates the generated secret in place.
+	if configSecret.IsNull() && !plan.SigningSecretVersion.Equal(state.SigningSecretVersion) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("signing_secret"), types.StringUnknown())...)
+	}
+}
+
+// webhookRequest builds the create/update payload from the model, resolving
+// the scope from the project slug when needed.
+func (r *WebhookResource) webhookRequest(ctx context.Context, data *WebhookResourceModel) (CreateWebhookRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var events []string
+	diags.Append(data.Events.ElementsAs(ctx, &events, false)...)
+	if diags.HasError() {
+		return CreateWebhookRequest{}, diags
+	}
+
+	var scope WebhookScope
+	diags.Append(data.Scope.As(ctx, &scope, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return CreateWebhookRequest{}, diags
+	}
+
+	if scope.ID.IsUnknown() || scope.Type.IsUnknown() {
+		diags.Append(r.resolveScope(ctx, &scope)...)
+		if diags.HasError() {
+			return CreateWebhookRequest{}, diags
+		}
+
+		scopeObj, d := types.ObjectValueFrom(ctx, webhookScopeAttrTypes, scope)
+		diags.Append(d...)
+		if diags.HasError() {
+			return CreateWebhookRequest{}, diags
+		}
+		data.Scope = scopeObj
+	}
+
+	webhookReq := CreateWebhookRequest{
+		Name:      data.Name.ValueString(),
+		URL:       data.URL.ValueString(),
+		Events:    events,
+		VerifyTLS: data.VerifyTLS.ValueBool(),
+		Scope: ScopeObject{
+			ID:   scope.ID.ValueString(),
+			Type: scope.Type.ValueString(),
+		},
+	}
+
+	if !data.SigningSecret.IsNull() {
+		webhookReq.SigningSecret = data.SigningSecret.ValueString()
+	}
+
+	return webhookReq, diags
+}
+
+// resolveScope fills in the scope id and type from the project slug.
+func (r *WebhookResource) resolveScope(ctx context.Context, scope *WebhookScope) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if scope.ID.IsUnknown() {
+		slug := scope.ProjectSlug.ValueString()
+		projectID, err := lookupProjectID(ctx, r.client, slug)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("scope").AtName("project_slug"),
+				"Client Error",
+				fmt.Sprintf("Unable to resolve project %q, got error: %s", slug, err),
+			)
+			return diags
+		}
+		scope.ID = types.StringValue(projectID)
+	}
+
+	if scope.Type.IsUnknown() {
+		scope.Type = types.StringValue("project")
+	}
+
+	return diags
+}
+
+// generateWebhookSecret returns a random hex-encoded signing secret.
+func generateWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/cedricfarinazzo/terraform-provider-circleci/webhooks"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testAccWebhookSecret = "test-webhook-secret"
+
+func TestAccWebhookResource(t *testing.T) {
+	receiver := webhooks.NewReceiver(testAccWebhookSecret)
+	defer receiver.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWebhookResourceConfig(receiver.URL, testAccWebhookSecret, `["workflow-completed"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("circleci_webhook.test", "url", receiver.URL),
+					resource.TestCheckResourceAttr("circleci_webhook.test", "events.#", "1"),
+					resource.TestCheckResourceAttr("circleci_webhook.test", "events.0", "workflow-completed"),
+					resource.TestCheckResourceAttr("circleci_webhook.test", "scope.type", "project"),
+					resource.TestCheckResourceAttrSet("circleci_webhook.test", "scope.id"),
+					resource.TestCheckResourceAttrSet("circleci_webhook.test", "id"),
+					testAccCheckWebhookSecretAccepted("circleci_webhook.test", receiver),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "circleci_webhook.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The secret is never returned and the slug is only known to the config
+				ImportStateVerifyIgnore: []string{"signing_secret", "scope.project_slug"},
+			},
+			// Changing events forces replacement
+			{
+				Config: testAccWebhookResourceConfig(receiver.URL, testAccWebhookSecret, `["workflow-completed", "job-completed"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("circleci_webhook.test", "events.#", "2"),
+					resource.TestCheckResourceAttr("circleci_webhook.test", "events.1", "job-completed"),
+					testAccCheckWebhookSecretAccepted("circleci_webhook.test", receiver),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWebhookResource_secretRotation(t *testing.T) {
+	var firstID, firstSecret string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookResourceConfigRotation("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("circleci_webhook.test", "signing_secret"),
+					testAccCaptureWebhook("circleci_webhook.test", &firstID, &firstSecret),
+				),
+			},
+			// Bumping the version rotates the secret in place
+			{
+				Config: testAccWebhookResourceConfigRotation("2"),
+				Check: func(s *terraform.State) error {
+					rs := s.RootModule().Resources["circleci_webhook.test"]
+					if rs.Primary.ID != firstID {
+						return fmt.Errorf("webhook was replaced: %s != %s", rs.Primary.ID, firstID)
+					}
+					if rs.Primary.Attributes["signing_secret"] == firstSecret {
+						return fmt.Errorf("signing secret was not rotated")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+// testAccCheckWebhookSecretAccepted delivers a sample payload for every event
+// in state, signed with the secret in state, and checks that the receiver
+// configured with the expected secret accepts it and that the event type it
+// parsed is one of the configured events.
+func testAccCheckWebhookSecretAccepted(name string, receiver *webhooks.Receiver) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["events.#"])
+		if err != nil {
+			return fmt.Errorf("reading events of %s: %w", name, err)
+		}
+		events := make(map[string]bool, count)
+		for i := 0; i < count; i++ {
+			events[rs.Primary.Attributes[fmt.Sprintf("events.%d", i)]] = true
+		}
+
+		for event := range events {
+			body := []byte(fmt.Sprintf(`{"id":"test-%s","type":%q,"workflow":{"name":"build"},"job":{"name":"test"}}`, event, event))
+			req, err := http.NewRequest(http.MethodPost, rs.Primary.Attributes["url"], bytes.NewReader(body))
+			if err != nil {
+				return err
+			}
+			req.Header.Set(webhooks.SignatureHeader, webhooks.Sign(rs.Primary.Attributes["signing_secret"], body))
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return err
+			}
+			resp.Body.Close()
+
+			deliveries := receiver.Deliveries()
+			delivery := deliveries[len(deliveries)-1]
+			if resp.StatusCode != http.StatusOK {
+				return fmt.Errorf("receiver rejected %s delivery with status %d: %v", event, resp.StatusCode, delivery.Err)
+			}
+
+			var received string
+			switch e := delivery.Event.(type) {
+			case *webhooks.WorkflowCompleted:
+				received = e.Type
+			case *webhooks.JobCompleted:
+				received = e.Type
+			}
+			if !events[received] {
+				return fmt.Errorf("receiver parsed event type %q, want one of the configured events %v", received, events)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCaptureWebhook(name string, id, secret *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+		*id = rs.Primary.ID
+		*secret = rs.Primary.Attributes["signing_secret"]
+		return nil
+	}
+}
+
+func testAccWebhookResourceConfig(url, secret, events string) string {
+	return `
+resource "circleci_webhook" "test" {
+  name           = "test-webhook"
+  url            = "` + url + `"
+  events         = ` + events + `
+  signing_secret = "` + secret + `"
+
+  scope = {
+    project_slug = "gh/test-org/test-repo"
+  }
+}
+`
+}
+
+func testAccWebhookResourceConfigRotation(version string) string {
+	return `
+resource "circleci_webhook" "test" {
+  name   = "rotating-webhook"
+  url    = "https://example.com/webhooks/circleci"
+  events = ["workflow-completed"]
+
+  signing_secret_version = "` + version + `"
+
+  scope = {
+    project_slug = "gh/test-org/test-repo"
+  }
+}
+`
+}
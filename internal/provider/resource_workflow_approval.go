@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &WorkflowApprovalResource{}
+
+func NewWorkflowApprovalResource() resource.Resource {
+	return &WorkflowApprovalResource{}
+}
+
+// WorkflowApprovalResource approves an approval job of a workflow when it is
+// created. Approvals cannot be withdrawn, so destroying the resource only
+// forgets it.
+type WorkflowApprovalResource struct {
+	client *CircleCIClient
+}
+
+type WorkflowApprovalResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	WorkflowID        types.String `tfsdk:"workflow_id"`
+	JobName           types.String `tfsdk:"job_name"`
+	ApprovalRequestID types.String `tfsdk:"approval_request_id"`
+	Status            types.String `tfsdk:"status"`
+}
+
+func (r *WorkflowApprovalResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_approval"
+}
+
+func (r *WorkflowApprovalResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CircleCI Workflow Approval resource. Approves an on-hold approval job of a workflow.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the approval (format: workflow_id:job_name).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workflow_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The unique identifier of the workflow.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"job_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the approval job.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"approval_request_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The approval request ID of the job.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the approval job.",
+			},
+		},
+	}
+}
+
+func (r *WorkflowApprovalResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*CircleCIClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CircleCIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WorkflowApprovalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WorkflowApprovalResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := r.findApprovalJob(ctx, data.WorkflowID.ValueString(), data.JobName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list workflow jobs, got error: %s", err))
+		return
+	}
+
+	if job == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("job_name"),
+			"Approval Job Not Found",
+			fmt.Sprintf("Workflow %s has no approval job named %q.", data.WorkflowID.ValueString(), data.JobName.ValueString()),
+		)
+		return
+	}
+
+	switch job.Status {
+	case "on_hold":
+		endpoint := fmt.Sprintf("/workflow/%s/approve/%s", data.WorkflowID.ValueString(), job.ApprovalRequestID)
+		if err := r.client.Post(ctx, endpoint, nil, nil); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to approve job, got error: %s", err))
+			return
+		}
+		job.Status = "success"
+	case "success":
+		// Someone already approved the job; nothing left to do.
+		tflog.Debug(ctx, "approval job already approved", map[string]interface{}{
+			"workflow_id": data.WorkflowID.ValueString(),
+			"job_name":    data.JobName.ValueString(),
+		})
+	default:
+		resp.Diagnostics.AddError(
+			"Approval Job Not On Hold",
+			fmt.Sprintf("Approval job %q of workflow %s has status %q; only jobs that are on hold can be approved.", data.JobName.ValueString(), data.WorkflowID.ValueString(), job.Status),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", data.WorkflowID.ValueString(), data.JobName.ValueString()))
+	data.ApprovalRequestID = types.StringValue(job.ApprovalRequestID)
+	data.Status = types.StringValue(job.Status)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowApprovalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WorkflowApprovalResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := r.findApprovalJob(ctx, data.WorkflowID.ValueString(), data.JobName.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list workflow jobs, got error: %s", err))
+		return
+	}
+
+	if job == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Status = types.StringValue(job.Status)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowApprovalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Approvals cannot be updated - every attribute forces a new approval
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"CircleCI workflow approvals cannot be updated. Changes require approving another job.",
+	)
+}
+
+func (r *WorkflowApprovalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Approvals cannot be withdrawn; removing the resource from state is enough.
+}
+
+// findApprovalJob returns the approval job of the workflow with the given
+// name, or nil if there is none.
+func (r *WorkflowApprovalResource) findApprovalJob(ctx context.Context, workflowID, jobName string) (*WorkflowJobAPI, error) {
+	jobs, err := listWorkflowJobs(ctx, r.client, workflowID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range jobs {
+		if jobs[i].Type == "approval" && jobs[i].Name == jobName {
+			return &jobs[i], nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"compress/gzip"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// usageExportGroupings lists the dimensions usage rows can be grouped by.
+var usageExportGroupings = []string{"project", "workflow", "job", "resource_class", "day"}
+
+// UsageRow is one job run from a usage export CSV.
+type UsageRow struct {
+	ProjectName    string
+	WorkflowName   string
+	JobName        string
+	ResourceClass  string
+	JobRunDate     string
+	JobRunSeconds  float64
+	ComputeCredits float64
+	DLCCredits     float64
+	NetworkCredits float64
+	StorageCredits float64
+	TotalCredits   float64
+}
+
+// UsageGroup aggregates the usage rows sharing a grouping key.
+type UsageGroup struct {
+	Key            string
+	JobRuns        int64
+	Minutes        float64
+	ComputeCredits float64
+	DLCCredits     float64
+	NetworkCredits float64
+	StorageCredits float64
+	TotalCredits   float64
+}
+
+// parseUsageExportCSV reads a gzipped usage export CSV. Columns are matched
+// by header name, case-insensitively, so new or reordered columns are fine.
+func parseUsageExportCSV(r io.Reader) ([]UsageRow, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("opening gzip stream: %w", err)
+	}
+	defer gz.Close()
+
+	reader := csv.NewReader(gz)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToUpper(strings.TrimSpace(name))] = i
+	}
+
+	var rows []UsageRow
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading line %d: %w", line, err)
+		}
+
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		var parseErr error
+		number := func(name string) float64 {
+			value := field(name)
+			if value == "" || parseErr != nil {
+				return 0
+			}
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				parseErr = fmt.Errorf("line %d: column %s: %w", line, name, err)
+			}
+			return f
+		}
+
+		row := UsageRow{
+			ProjectName:    field("PROJECT_NAME"),
+			WorkflowName:   field("WORKFLOW_NAME"),
+			JobName:        field("JOB_NAME"),
+			ResourceClass:  field("RESOURCE_CLASS"),
+			JobRunDate:     field("JOB_RUN_DATE"),
+			JobRunSeconds:  number("JOB_RUN_SECONDS"),
+			ComputeCredits: number("COMPUTE_CREDITS"),
+			DLCCredits:     number("DLC_CREDITS"),
+			NetworkCredits: number("NETWORK_CREDITS"),
+			StorageCredits: number("STORAGE_CREDITS"),
+			TotalCredits:   number("TOTAL_CREDITS"),
+		}
+		if parseErr != nil {
+			return nil, parseErr
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// groupUsageRows aggregates rows by one of usageExportGroupings. Groups are
+// sorted by descending total credits, then by key.
+func groupUsageRows(rows []UsageRow, groupBy string) ([]UsageGroup, error) {
+	key, err := usageGroupKey(groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := map[string]*UsageGroup{}
+	for _, row := range rows {
+		k := key(row)
+		group, ok := groups[k]
+		if !ok {
+			group = &UsageGroup{Key: k}
+			groups[k] = group
+		}
+
+		group.JobRuns++
+		group.Minutes += row.JobRunSeconds / 60
+		group.ComputeCredits += row.ComputeCredits
+		group.DLCCredits += row.DLCCredits
+		group.NetworkCredits += row.NetworkCredits
+		group.StorageCredits += row.StorageCredits
+		group.TotalCredits += row.TotalCredits
+	}
+
+	result := make([]UsageGroup, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, *group)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].TotalCredits != result[j].TotalCredits {
+			return result[i].TotalCredits > result[j].TotalCredits
+		}
+		return result[i].Key < result[j].Key
+	})
+
+	return result, nil
+}
+
+func usageGroupKey(groupBy string) (func(UsageRow) string, error) {
+	switch groupBy {
+	case "project":
+		return func(r UsageRow) string { return r.ProjectName }, nil
+	case "workflow":
+		return func(r UsageRow) string { return r.ProjectName + "/" + r.WorkflowName }, nil
+	case "job":
+		return func(r UsageRow) string { return r.ProjectName + "/" + r.WorkflowName + "/" + r.JobName }, nil
+	case "resource_class":
+		return func(r UsageRow) string { return r.ResourceClass }, nil
+	case "day":
+		// JOB_RUN_DATE may carry a time component; keep the date only.
+		return func(r UsageRow) string {
+			if len(r.JobRunDate) >= len("2006-01-02") {
+				return r.JobRunDate[:len("2006-01-02")]
+			}
+			return r.JobRunDate
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown grouping %q, expected one of %s", groupBy, strings.Join(usageExportGroupings, ", "))
+	}
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"bytes"
+	"compress/gzip"
+	"math"
+	"testing"
+)
+
+const testUsageCSV = `ORGANIZATION_NAME,PROJECT_NAME,WORKFLOW_NAME,JOB_NAME,JOB_RUN_DATE,RESOURCE_CLASS,JOB_RUN_SECONDS,COMPUTE_CREDITS,DLC_CREDITS,NETWORK_CREDITS,STORAGE_CREDITS,TOTAL_CREDITS
+acme,api,build,test,2024-01-02,medium,120,20,5,1,0.5,26.5
+acme,api,build,lint,2024-01-02,small,60,5,0,0,0,5
+acme,web,deploy,deploy,2024-01-03 10:00:00,large,300,100,0,2,1,103
+`
+
+func gzipString(t *testing.T, s string) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write([]byte(s)); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return &buf
+}
+
+func TestParseUsageExportCSV(t *testing.T) {
+	rows, err := parseUsageExportCSV(gzipString(t, testUsageCSV))
+	if err != nil {
+		t.Fatalf("parseUsageExportCSV() error = %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("got %d rows, want 3", len(rows))
+	}
+
+	want := UsageRow{
+		ProjectName:    "api",
+		WorkflowName:   "build",
+		JobName:        "test",
+		ResourceClass:  "medium",
+		JobRunDate:     "2024-01-02",
+		JobRunSeconds:  120,
+		ComputeCredits: 20,
+		DLCCredits:     5,
+		NetworkCredits: 1,
+		StorageCredits: 0.5,
+		TotalCredits:   26.5,
+	}
+	if rows[0] != want {
+		t.Errorf("rows[0] = %+v, want %+v", rows[0], want)
+	}
+}
+
+func TestParseUsageExportCSV_invalid(t *testing.T) {
+	if _, err := parseUsageExportCSV(bytes.NewBufferString(testUsageCSV)); err == nil {
+		t.Error("expected an error for a non-gzip stream")
+	}
+
+	bad := "PROJECT_NAME,TOTAL_CREDITS\napi,lots\n"
+	if _, err := parseUsageExportCSV(gzipString(t, bad)); err == nil {
+		t.Error("expected an error for a non-numeric credit column")
+	}
+
+	rows, err := parseUsageExportCSV(gzipString(t, ""))
+	if err != nil || len(rows) != 0 {
+		t.Errorf("empty export = %v, %v; want no rows", rows, err)
+	}
+}
+
+func TestGroupUsageRows(t *testing.T) {
+	rows, err := parseUsageExportCSV(gzipString(t, testUsageCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		groupBy  string
+		wantKeys []string
+		wantTop  float64
+	}{
+		{groupBy: "project", wantKeys: []string{"web", "api"}, wantTop: 103},
+		{groupBy: "workflow", wantKeys: []string{"web/deploy", "api/build"}, wantTop: 103},
+		{groupBy: "job", wantKeys: []string{"web/deploy/deploy", "api/build/test", "api/build/lint"}, wantTop: 103},
+		{groupBy: "resource_class", wantKeys: []string{"large", "medium", "small"}, wantTop: 103},
+		{groupBy: "day", wantKeys: []string{"2024-01-03", "2024-01-02"}, wantTop: 103},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.groupBy, func(t *testing.T) {
+			groups, err := groupUsageRows(rows, tt.groupBy)
+			if err != nil {
+				t.Fatalf("groupUsageRows() error = %v", err)
+			}
+			if len(groups) != len(tt.wantKeys) {
+				t.Fatalf("got %d groups, want %d: %+v", len(groups), len(tt.wantKeys), groups)
+			}
+			for i, key := range tt.wantKeys {
+				if groups[i].Key != key {
+					t.Errorf("groups[%d].Key = %q, want %q", i, groups[i].Key, key)
+				}
+			}
+			if groups[0].TotalCredits != tt.wantTop {
+				t.Errorf("top group credits = %v, want %v", groups[0].TotalCredits, tt.wantTop)
+			}
+		})
+	}
+
+	groups, _ := groupUsageRows(rows, "project")
+	api := groups[1]
+	if api.JobRuns != 2 || math.Abs(api.Minutes-3) > 1e-9 || api.TotalCredits != 31.5 || api.DLCCredits != 5 {
+		t.Errorf("unexpected api group %+v", api)
+	}
+
+	if _, err := groupUsageRows(rows, "branch"); err == nil {
+		t.Error("expected an error for an unknown grouping")
+	}
+}
@@ -0,0 +1,147 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Event types sent by CircleCI.
+const (
+	EventWorkflowCompleted = "workflow-completed"
+	EventJobCompleted      = "job-completed"
+)
+
+// Envelope holds the fields shared by every webhook payload.
+type Envelope struct {
+	ID           string       `json:"id"`
+	Type         string       `json:"type"`
+	HappenedAt   time.Time    `json:"happened_at"`
+	Webhook      Webhook      `json:"webhook"`
+	Project      Project      `json:"project"`
+	Organization Organization `json:"organization"`
+	Pipeline     Pipeline     `json:"pipeline"`
+}
+
+// WorkflowCompleted is sent when a workflow reaches a terminal state.
+type WorkflowCompleted struct {
+	Envelope
+	Workflow Workflow `json:"workflow"`
+}
+
+// JobCompleted is sent when a job reaches a terminal state.
+type JobCompleted struct {
+	Envelope
+	Workflow Workflow `json:"workflow"`
+	Job      Job      `json:"job"`
+}
+
+// Webhook identifies the webhook that sent the payload.
+type Webhook struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Project is the project the event happened in.
+type Project struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// Organization is the organization that owns the project.
+type Organization struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Pipeline is the pipeline the workflow or job belongs to. VCS is nil for
+// pipelines not triggered from a repository.
+type Pipeline struct {
+	ID        string    `json:"id"`
+	Number    int64     `json:"number"`
+	CreatedAt time.Time `json:"created_at"`
+	Trigger   Trigger   `json:"trigger"`
+	VCS       *VCS      `json:"vcs,omitempty"`
+}
+
+// Trigger describes what started the pipeline, such as "webhook", "api" or
+// "schedule".
+type Trigger struct {
+	Type string `json:"type"`
+}
+
+// VCS describes the repository and revision a pipeline ran on. Branch and
+// Tag are mutually exclusive.
+type VCS struct {
+	ProviderName        string  `json:"provider_name"`
+	OriginRepositoryURL string  `json:"origin_repository_url"`
+	TargetRepositoryURL string  `json:"target_repository_url"`
+	Revision            string  `json:"revision"`
+	Branch              string  `json:"branch,omitempty"`
+	Tag                 string  `json:"tag,omitempty"`
+	Commit              *Commit `json:"commit,omitempty"`
+}
+
+// Commit is the commit a pipeline ran on. CommittedAt is nil when CircleCI
+// did not receive it.
+type Commit struct {
+	Subject     string     `json:"subject"`
+	Body        string     `json:"body"`
+	Author      Person     `json:"author"`
+	AuthoredAt  time.Time  `json:"authored_at"`
+	Committer   Person     `json:"committer"`
+	CommittedAt *time.Time `json:"committed_at,omitempty"`
+}
+
+// Person is the author or committer of a commit.
+type Person struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// Workflow is the workflow the event is about. StoppedAt is nil while it is
+// still running.
+type Workflow struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Status    string     `json:"status"`
+	CreatedAt time.Time  `json:"created_at"`
+	StoppedAt *time.Time `json:"stopped_at,omitempty"`
+	URL       string     `json:"url"`
+}
+
+// Job is the job a job-completed event is about. StartedAt is nil for jobs
+// that never started, such as approvals that were not approved.
+type Job struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Number    int64      `json:"number"`
+	Status    string     `json:"status"`
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	StoppedAt *time.Time `json:"stopped_at,omitempty"`
+}
+
+// Parse decodes a webhook body into a *WorkflowCompleted or *JobCompleted
+// depending on its type.
+func Parse(body []byte) (any, error) {
+	var envelope Envelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, fmt.Errorf("decoding payload: %w", err)
+	}
+
+	var event any
+	switch envelope.Type {
+	case EventWorkflowCompleted:
+		event = &WorkflowCompleted{}
+	case EventJobCompleted:
+		event = &JobCompleted{}
+	default:
+		return nil, fmt.Errorf("unknown event type %q", envelope.Type)
+	}
+
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, fmt.Errorf("decoding %s payload: %w", envelope.Type, err)
+	}
+	return event, nil
+}
@@ -0,0 +1,100 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+)
+
+// Delivery is a webhook request received by a Receiver.
+type Delivery struct {
+	Header http.Header
+	Body   []byte
+	// Event is the parsed payload, nil when verification or parsing failed.
+	Event any
+	// Err is the verification or parsing error, if any.
+	Err error
+}
+
+// Receiver is an httptest server that records and verifies webhook
+// deliveries. Requests with a valid signature get a 200 response, others a
+// 401; every request is recorded either way.
+type Receiver struct {
+	// URL is the address to configure as the webhook url.
+	URL string
+
+	server     *httptest.Server
+	secret     string
+	mu         sync.Mutex
+	deliveries []Delivery
+	notify     chan struct{}
+}
+
+// NewReceiver starts a Receiver that verifies deliveries with secret. Call
+// Close when done.
+func NewReceiver(secret string) *Receiver {
+	r := &Receiver{
+		secret: secret,
+		notify: make(chan struct{}, 1),
+	}
+	r.server = httptest.NewServer(http.HandlerFunc(r.handle))
+	r.URL = r.server.URL
+	return r
+}
+
+// Close shuts down the server.
+func (r *Receiver) Close() {
+	r.server.Close()
+}
+
+// Deliveries returns the deliveries received so far.
+func (r *Receiver) Deliveries() []Delivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Delivery(nil), r.deliveries...)
+}
+
+// Wait blocks until at least n deliveries have been received or ctx is done.
+func (r *Receiver) Wait(ctx context.Context, n int) ([]Delivery, error) {
+	for {
+		deliveries := r.Deliveries()
+		if len(deliveries) >= n {
+			return deliveries, nil
+		}
+
+		select {
+		case <-r.notify:
+		case <-ctx.Done():
+			return deliveries, ctx.Err()
+		}
+	}
+}
+
+func (r *Receiver) handle(w http.ResponseWriter, req *http.Request) {
+	delivery := Delivery{Header: req.Header.Clone()}
+
+	status := http.StatusOK
+	body, err := VerifyRequest(req, r.secret)
+	if err != nil {
+		status = http.StatusUnauthorized
+		// VerifyRequest restores the body, so keep it for inspection.
+		body, _ = io.ReadAll(req.Body)
+	} else if delivery.Event, err = Parse(body); err != nil {
+		status = http.StatusBadRequest
+	}
+	delivery.Body = body
+	delivery.Err = err
+
+	r.mu.Lock()
+	r.deliveries = append(r.deliveries, delivery)
+	r.mu.Unlock()
+
+	select {
+	case r.notify <- struct{}{}:
+	default:
+	}
+
+	w.WriteHeader(status)
+}
@@ -0,0 +1,86 @@
+// Package webhooks helps services that consume CircleCI outbound webhooks.
+// It verifies the circleci-signature header against the signing secret
+// managed by the circleci_webhook resource, decodes the workflow-completed
+// and job-completed payloads, and provides an httptest receiver for tests.
+package webhooks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// SignatureHeader is the HTTP header carrying the payload signature.
+const SignatureHeader = "circleci-signature"
+
+// signatureVersion is the only signature scheme CircleCI currently sends.
+const signatureVersion = "v1"
+
+var (
+	// ErrMissingSignature is returned when no v1 signature is present.
+	ErrMissingSignature = errors.New("missing v1 signature")
+	// ErrInvalidSignature is returned when no signature matches the payload.
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+// Sign returns the header value CircleCI would send for body, in the form
+// "v1=<hex>".
+func Sign(secret string, body []byte) string {
+	return signatureVersion + "=" + hex.EncodeToString(computeMAC(secret, body))
+}
+
+// Verify checks body against a circleci-signature header value. The header
+// may hold several comma-separated signatures; any matching v1 signature is
+// accepted and other versions are ignored.
+func Verify(secret string, body []byte, header string) error {
+	expected := computeMAC(secret, body)
+
+	found := false
+	for _, part := range strings.Split(header, ",") {
+		version, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok || version != signatureVersion {
+			continue
+		}
+		found = true
+
+		mac, err := hex.DecodeString(value)
+		if err != nil {
+			continue
+		}
+		if hmac.Equal(mac, expected) {
+			return nil
+		}
+	}
+
+	if !found {
+		return ErrMissingSignature
+	}
+	return ErrInvalidSignature
+}
+
+// VerifyRequest reads and verifies the body of r. The body is restored so
+// that later handlers can read it again.
+func VerifyRequest(r *http.Request, secret string) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading body: %w", err)
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err := Verify(secret, body, r.Header.Get(SignatureHeader)); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+func computeMAC(secret string, body []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,184 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+const testSecret = "test-secret"
+
+const workflowCompletedPayload = `{
+  "id": "3888f21b-eaa7-38e3-8f3d-75a63bba8895",
+  "type": "workflow-completed",
+  "happened_at": "2021-09-01T22:49:34.317Z",
+  "webhook": {"id": "cf8c4fdd-0587-4da1-b4ca-4846e9640af9", "name": "Sample Webhook"},
+  "project": {"id": "84996744-a854-4f5e-aea3-04e2851dc1d2", "name": "webhook-service", "slug": "github/circleci/webhook-service"},
+  "organization": {"id": "f22b6566-597d-46d5-ba74-99ef5bb3d85c", "name": "circleci"},
+  "pipeline": {
+    "id": "1285fe1d-d3a6-44fc-8886-8979558254c4",
+    "number": 130,
+    "created_at": "2021-09-01T22:49:03.616Z",
+    "trigger": {"type": "webhook"},
+    "vcs": {
+      "provider_name": "github",
+      "origin_repository_url": "https://github.com/circleci/webhook-service",
+      "target_repository_url": "https://github.com/circleci/webhook-service",
+      "revision": "1dc6aa69429bff4806ad6afe58d3d8f57e25973e",
+      "branch": "main",
+      "commit": {
+        "subject": "Add webhook payloads",
+        "body": "",
+        "author": {"name": "Author Name", "email": "author@example.com"},
+        "authored_at": "2021-09-01T22:48:41Z",
+        "committer": {"name": "Committer Name", "email": "committer@example.com"},
+        "committed_at": "2021-09-01T22:48:41Z"
+      }
+    }
+  },
+  "workflow": {
+    "id": "fda08377-fe7e-46b1-8992-3a7aaecac9c3",
+    "name": "build-test-deploy",
+    "status": "success",
+    "created_at": "2021-09-01T22:49:03.616Z",
+    "stopped_at": "2021-09-01T22:49:34.170Z",
+    "url": "https://app.circleci.com/pipelines/github/circleci/webhook-service/130/workflows/fda08377-fe7e-46b1-8992-3a7aaecac9c3"
+  }
+}`
+
+const jobCompletedPayload = `{
+  "id": "8bd26d6a-5fe7-3b2c-9ba6-d8fcd4c26ece",
+  "type": "job-completed",
+  "happened_at": "2021-09-01T22:49:34.279Z",
+  "webhook": {"id": "cf8c4fdd-0587-4da1-b4ca-4846e9640af9", "name": "Sample Webhook"},
+  "project": {"id": "84996744-a854-4f5e-aea3-04e2851dc1d2", "name": "webhook-service", "slug": "github/circleci/webhook-service"},
+  "organization": {"id": "f22b6566-597d-46d5-ba74-99ef5bb3d85c", "name": "circleci"},
+  "pipeline": {"id": "1285fe1d-d3a6-44fc-8886-8979558254c4", "number": 130, "created_at": "2021-09-01T22:49:03.616Z", "trigger": {"type": "webhook"}},
+  "workflow": {"id": "fda08377-fe7e-46b1-8992-3a7aaecac9c3", "name": "build-test-deploy", "status": "success", "created_at": "2021-09-01T22:49:03.616Z", "url": ""},
+  "job": {
+    "id": "8bd26d6a-5fe7-3b2c-9ba6-d8fcd4c26ece",
+    "name": "test",
+    "number": 136,
+    "status": "failed",
+    "started_at": "2021-09-01T22:49:28.841Z",
+    "stopped_at": "2021-09-01T22:49:34.170Z"
+  }
+}`
+
+func TestVerify(t *testing.T) {
+	body := []byte(workflowCompletedPayload)
+	valid := Sign(testSecret, body)
+
+	tests := []struct {
+		name   string
+		header string
+		want   error
+	}{
+		{name: "valid", header: valid},
+		{name: "valid among several", header: "v2=abcdef, " + valid + ",v1=00"},
+		{name: "wrong secret", header: Sign("other-secret", body), want: ErrInvalidSignature},
+		{name: "not hex", header: "v1=zz", want: ErrInvalidSignature},
+		{name: "other version only", header: "v0=" + valid[3:], want: ErrMissingSignature},
+		{name: "empty", header: "", want: ErrMissingSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Verify(testSecret, body, tt.header); !errors.Is(err, tt.want) {
+				t.Errorf("Verify() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyTamperedBody(t *testing.T) {
+	header := Sign(testSecret, []byte(workflowCompletedPayload))
+	if err := Verify(testSecret, []byte(jobCompletedPayload), header); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Verify() = %v, want %v", err, ErrInvalidSignature)
+	}
+}
+
+func TestParse(t *testing.T) {
+	event, err := Parse([]byte(workflowCompletedPayload))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	workflow, ok := event.(*WorkflowCompleted)
+	if !ok {
+		t.Fatalf("Parse() = %T, want *WorkflowCompleted", event)
+	}
+	if workflow.Workflow.Name != "build-test-deploy" || workflow.Workflow.Status != "success" {
+		t.Errorf("unexpected workflow %+v", workflow.Workflow)
+	}
+	if workflow.Pipeline.VCS == nil || workflow.Pipeline.VCS.Branch != "main" {
+		t.Errorf("unexpected pipeline vcs %+v", workflow.Pipeline.VCS)
+	}
+
+	event, err = Parse([]byte(jobCompletedPayload))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	job, ok := event.(*JobCompleted)
+	if !ok {
+		t.Fatalf("Parse() = %T, want *JobCompleted", event)
+	}
+	if job.Job.Number != 136 || job.Job.Status != "failed" {
+		t.Errorf("unexpected job %+v", job.Job)
+	}
+	if job.Project.Slug != "github/circleci/webhook-service" {
+		t.Errorf("unexpected project %+v", job.Project)
+	}
+
+	if _, err := Parse([]byte(`{"type": "ping"}`)); err == nil {
+		t.Error("Parse() with unknown type should fail")
+	}
+}
+
+func TestReceiver(t *testing.T) {
+	receiver := NewReceiver(testSecret)
+	defer receiver.Close()
+
+	send := func(secret, payload string) int {
+		req, err := http.NewRequest(http.MethodPost, receiver.URL, bytes.NewBufferString(payload))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set(SignatureHeader, Sign(secret, []byte(payload)))
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	if code := send(testSecret, jobCompletedPayload); code != http.StatusOK {
+		t.Errorf("valid delivery got status %d", code)
+	}
+	if code := send("wrong-secret", jobCompletedPayload); code != http.StatusUnauthorized {
+		t.Errorf("forged delivery got status %d", code)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	deliveries, err := receiver.Wait(ctx, 2)
+	if err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+	if deliveries[0].Err != nil {
+		t.Errorf("first delivery error = %v", deliveries[0].Err)
+	}
+	if _, ok := deliveries[0].Event.(*JobCompleted); !ok {
+		t.Errorf("first delivery event = %T, want *JobCompleted", deliveries[0].Event)
+	}
+	if !errors.Is(deliveries[1].Err, ErrInvalidSignature) {
+		t.Errorf("second delivery error = %v, want %v", deliveries[1].Err, ErrInvalidSignature)
+	}
+	if len(deliveries[1].Body) == 0 {
+		t.Error("rejected delivery should keep its body")
+	}
+}